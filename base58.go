@@ -2,7 +2,10 @@
 package base58
 
 import (
+	"bytes"
 	"fmt"
+	"math/bits"
+	"slices"
 	"strconv"
 )
 
@@ -12,7 +15,8 @@ type Encoding struct {
 	decodeMap [256]int64
 }
 
-// New creates a new base58 encoding.
+// New creates a new base58 encoding. The alphabet is not validated, use
+// NewEncoding to create an encoding from an alphabet given by users.
 func New(alphabet []byte) *Encoding {
 	enc := &Encoding{}
 	copy(enc.alphabet[:], alphabet)
@@ -25,13 +29,34 @@ func New(alphabet []byte) *Encoding {
 	return enc
 }
 
+// NewEncoding creates a new base58 encoding, validating that the alphabet
+// consists of 58 distinct printable ASCII characters other than space.
+func NewEncoding(alphabet []byte) (*Encoding, error) {
+	if len(alphabet) != int(radix) {
+		return nil, fmt.Errorf("invalid base58 alphabet length: expected %d but got %d",
+			radix, len(alphabet))
+	}
+	for i, c := range alphabet {
+		if c <= ' ' || '~' < c {
+			return nil, fmt.Errorf("invalid character %q at offset %d in base58 alphabet", c, i)
+		}
+		if j := bytes.IndexByte(alphabet[:i], c); j >= 0 {
+			return nil, fmt.Errorf("duplicate character '%c' at offsets %d and %d in base58 alphabet", c, j, i)
+		}
+	}
+	return New(alphabet), nil
+}
+
 // FlickrEncoding is the encoding scheme used for Flickr's short URLs.
+// The alphabet is not in byte order, lowercase letters precede uppercase ones.
 var FlickrEncoding = New([]byte("123456789abcdefghijkmnopqrstuvwxyzABCDEFGHJKLMNPQRSTUVWXYZ"))
 
 // RippleEncoding is the encoding scheme used for Ripple addresses.
+// The alphabet is not in byte order.
 var RippleEncoding = New([]byte("rpshnaf39wBUDNEGHJKLM4PQRST7VWXYZ2bcdeCg65jkm8oFqi1tuvAxyz"))
 
 // BitcoinEncoding is the encoding scheme used for Bitcoin addresses.
+// The alphabet is in byte order, so the fixed-width encoding preserves order.
 var BitcoinEncoding = New([]byte("123456789ABCDEFGHJKLMNPQRSTUVWXYZabcdefghijkmnopqrstuvwxyz"))
 
 const (
@@ -40,15 +65,41 @@ const (
 	overflow = 100000000000000000 // 10^slice
 )
 
-type encodeError []byte
+// EncodedLen returns the maximum length in bytes of the base58 encoding of
+// the number of n digits in base 10.
+func EncodedLen(n int) int {
+	return n
+}
 
-func (err encodeError) Error() string {
-	return fmt.Sprintf("expecting a non-negative number but got %q", []byte(err))
+// DecodedLen returns the maximum length in bytes of the number in base 10
+// decoded from n bytes of base58 encoded data.
+func DecodedLen(n int) int {
+	return (n*1764 + 999) / 1000 // log(58)/log(10) < 1.764
 }
 
 // Encode encodes the number represented in the byte slice base 10.
 func (enc *Encoding) Encode(src []byte) ([]byte, error) {
-	buf := make([]byte, len(src))
+	buf, err := enc.AppendEncode(make([]byte, 0, EncodedLen(len(src))), src)
+	if err != nil {
+		return nil, err
+	}
+	return buf, nil
+}
+
+// AppendEncode appends the base58 encoding of the number represented in src
+// base 10 to dst, and returns the extended buffer.
+func (enc *Encoding) AppendEncode(dst, src []byte) ([]byte, error) {
+	l, n := len(dst), EncodedLen(len(src))
+	dst = slices.Grow(dst, n)[:l+n]
+	buf, err := enc.encode(dst[l:], src)
+	if err != nil {
+		return dst[:l], err
+	}
+	return dst[:l+copy(dst[l:], buf)], nil
+}
+
+// encode encodes src to the tail of buf, which has the length of src.
+func (enc *Encoding) encode(buf, src []byte) ([]byte, error) {
 	var zerocnt int
 	for _, c := range src {
 		if c == '0' {
@@ -58,9 +109,9 @@ func (enc *Encoding) Encode(src []byte) ([]byte, error) {
 		}
 	}
 	if len(src)-zerocnt < 20 { // 10^19 < math.MaxUint64 < 10^20
-		n, err := parseUint64(src[zerocnt:])
-		if err != nil {
-			return nil, err
+		n, ok := parseUint64(src[zerocnt:])
+		if !ok {
+			return nil, newInvalidDigitError(src, 0)
 		}
 		buf, i := enc.appendEncodeUint64(buf, n)
 		for ; zerocnt > 0; zerocnt-- {
@@ -69,16 +120,30 @@ func (enc *Encoding) Encode(src []byte) ([]byte, error) {
 		}
 		return buf[i:], nil
 	}
+	if len(src)-zerocnt >= bigThreshold {
+		for _, c := range src {
+			if decimalDecodeMap[c] < 0 {
+				return nil, newInvalidDigitError(src, 0)
+			}
+		}
+		x := base10Converter.parse(src[zerocnt:], &decimalDecodeMap)
+		xs := enc.EncodeBigInt(x)
+		i := len(buf) - copy(buf[len(buf)-len(xs):], xs)
+		for ; zerocnt > 0; zerocnt-- {
+			i--
+			buf[i] = enc.alphabet[0]
+		}
+		return buf[i:], nil
+	}
 	xs := make([]uint64, (len(src)+(slice-1))/slice)
 	j, k := len(src)-slice, len(src)
-	var err error
+	var ok bool
 	for i := len(xs) - 1; i >= 0; i-- {
 		if j < 0 {
 			j = 0
 		}
-		xs[i], err = parseUint64(src[j:k])
-		if err != nil {
-			return nil, encodeError(src)
+		if xs[i], ok = parseUint64(src[j:k]); !ok {
+			return nil, newInvalidDigitError(src, 0)
 		}
 		j, k = j-slice, j
 	}
@@ -118,6 +183,18 @@ func (enc *Encoding) EncodeUint64(n uint64) []byte {
 	return buf[i:]
 }
 
+// AppendEncodeUint64 appends the base58 encoding of the unsigned integer to
+// dst, and returns the extended buffer.
+func (enc *Encoding) AppendEncodeUint64(dst []byte, n uint64) []byte {
+	if n == 0 {
+		return append(dst, enc.alphabet[0])
+	}
+	l := len(dst)
+	dst = slices.Grow(dst, 11)[:l+11] // 58^10 < math.MaxUint64 < 58^11
+	buf, i := enc.appendEncodeUint64(dst[l:], n)
+	return dst[:l+copy(buf, buf[i:])]
+}
+
 func (enc *Encoding) appendEncodeUint64(buf []byte, n uint64) ([]byte, int) {
 	i := len(buf)
 	var mod uint64
@@ -131,10 +208,21 @@ func (enc *Encoding) appendEncodeUint64(buf []byte, n uint64) ([]byte, int) {
 
 // Decode decodes the base58 encoded bytes.
 func (enc *Encoding) Decode(src []byte) ([]byte, error) {
+	buf, err := enc.AppendDecode(make([]byte, 0, DecodedLen(len(src))), src)
+	if err != nil {
+		return nil, err
+	}
+	return buf, nil
+}
+
+// AppendDecode appends the number in base 10 decoded from the base58 encoded
+// bytes to dst, and returns the extended buffer.
+func (enc *Encoding) AppendDecode(dst, src []byte) ([]byte, error) {
 	if len(src) == 0 {
-		return []byte{}, nil
+		return dst, nil
 	}
-	buf := make([]byte, 0, len(src)*2)
+	l := len(dst)
+	buf := dst
 	for i, c := range src {
 		if c == enc.alphabet[0] && i < len(src)-1 {
 			buf = append(buf, '0')
@@ -142,18 +230,27 @@ func (enc *Encoding) Decode(src []byte) ([]byte, error) {
 			break
 		}
 	}
-	if len(src[len(buf):]) < 11 { // 58^10 < math.MaxUint64 < 58^11
-		n, err := enc.DecodeUint64(src[len(buf):])
+	if zerocnt := len(buf) - l; len(src[zerocnt:]) < 11 { // 58^10 < math.MaxUint64 < 58^11
+		n, err := enc.decodeUint(src, zerocnt, 64)
 		if err != nil {
-			return nil, err
+			return dst[:l], err
 		}
 		return strconv.AppendUint(buf, n, 10), nil
 	}
+	if zerocnt := len(buf) - l; len(src)-zerocnt >= bigThreshold {
+		for k, c := range src {
+			if enc.decodeMap[c] < 0 {
+				return dst[:l], newCorruptInputError("base58", src, k)
+			}
+		}
+		x := base58Converter.parse(src[zerocnt:], &enc.decodeMap)
+		return append(buf, trimLeadingZeros(base10Converter.format(x, decimalAlphabet), '0')...), nil
+	}
 	xs := make([]uint64, 0, len(src)/9+1) // > log_{overflow}(58^len(src))+1
 	var i int64
-	for _, c := range src {
+	for k, c := range src {
 		if i = enc.decodeMap[c]; i < 0 {
-			return nil, fmt.Errorf("invalid character '%c' in decoding a base58 string %q", c, src)
+			return dst[:l], newCorruptInputError("base58", src, k)
 		}
 		carry := uint64(i)
 		for j, x := range xs {
@@ -181,44 +278,137 @@ func (enc *Encoding) Decode(src []byte) ([]byte, error) {
 
 // DecodeUint64 decodes the base58 encoded bytes to an unsigned integer.
 func (enc *Encoding) DecodeUint64(src []byte) (uint64, error) {
-	var n, m uint64
+	return enc.decodeUint(src, 0, 64)
+}
+
+// DecodeUint32 decodes the base58 encoded bytes to a 32-bit unsigned integer.
+func (enc *Encoding) DecodeUint32(src []byte) (uint32, error) {
+	n, err := enc.decodeUint(src, 0, 32)
+	return uint32(n), err
+}
+
+// DecodeUint16 decodes the base58 encoded bytes to a 16-bit unsigned integer.
+func (enc *Encoding) DecodeUint16(src []byte) (uint16, error) {
+	n, err := enc.decodeUint(src, 0, 16)
+	return uint16(n), err
+}
+
+// decodeUint decodes src[start:] to an unsigned integer of the bit size, and
+// reports errors with the offsets in src.
+func (enc *Encoding) decodeUint(src []byte, start, bitSize int) (uint64, error) {
+	var n, hi, carry uint64
 	var i int64
-	for _, c := range src {
+	for k, c := range src[start:] {
 		if i = enc.decodeMap[c]; i < 0 {
-			return 0, fmt.Errorf("invalid character '%c' in decoding a base58 string %q", c, src)
+			return 0, newCorruptInputError("base58", src, start+k)
 		}
-		m = n*radix + uint64(i)
-		if m < n {
-			return 0, fmt.Errorf("overflow in decoding a base58 string %q", src)
+		hi, n = bits.Mul64(n, radix)
+		n, carry = bits.Add64(n, uint64(i), 0)
+		if hi > 0 || carry > 0 || n>>bitSize > 0 {
+			return 0, overflowError("base58", src, "uint"+strconv.Itoa(bitSize))
 		}
-		n = m
 	}
 	return n, nil
 }
 
-// UnmarshalFlag implements flags.Unmarshaler
-func (enc *Encoding) UnmarshalFlag(value string) error {
-	switch value {
-	case "flickr":
-		*enc = *FlickrEncoding
-	case "ripple":
-		*enc = *RippleEncoding
-	case "bitcoin":
-		*enc = *BitcoinEncoding
-	default:
-		return fmt.Errorf("unknown encoding: %s", value)
+// EncodeBytes encodes the byte slice as a big-endian binary number. Each
+// leading zero byte is encoded to the first character of the alphabet, so the
+// result is compatible with the reference implementation of Bitcoin.
+func (enc *Encoding) EncodeBytes(src []byte) []byte {
+	var zerocnt int
+	for zerocnt < len(src) && src[zerocnt] == 0 {
+		zerocnt++
+	}
+	buf := make([]byte, zerocnt+(len(src)-zerocnt)*138/100+1) // log(256)/log(58) < 1.38
+	i := len(buf)
+	for _, c := range src[zerocnt:] {
+		carry := uint64(c)
+		j := len(buf)
+		for ; j > i || carry > 0; j-- {
+			carry += uint64(buf[j-1]) << 8
+			buf[j-1], carry = byte(carry%radix), carry/radix
+		}
+		i = j
+	}
+	for j := i; j < len(buf); j++ {
+		buf[j] = enc.alphabet[buf[j]]
+	}
+	for ; zerocnt > 0; zerocnt-- {
+		i--
+		buf[i] = enc.alphabet[0]
+	}
+	return buf[i:]
+}
+
+// DecodeBytes decodes the base58 encoded bytes to a big-endian binary number.
+// Each leading first character of the alphabet is decoded to a zero byte.
+func (enc *Encoding) DecodeBytes(src []byte) ([]byte, error) {
+	var zerocnt int
+	for zerocnt < len(src) && src[zerocnt] == enc.alphabet[0] {
+		zerocnt++
+	}
+	buf := make([]byte, zerocnt+(len(src)-zerocnt)*733/1000+1) // log(58)/log(256) < 0.733
+	i := len(buf)
+	for k, c := range src[zerocnt:] {
+		d := enc.decodeMap[c]
+		if d < 0 {
+			return nil, newCorruptInputError("base58", src, zerocnt+k)
+		}
+		carry := uint64(d)
+		j := len(buf)
+		for ; j > i || carry > 0; j-- {
+			carry += uint64(buf[j-1]) * radix
+			buf[j-1], carry = byte(carry), carry>>8
+		}
+		i = j
+	}
+	return buf[i-zerocnt:], nil
+}
+
+// DecodeInto decodes the base58 encoded bytes into dst in the same way as
+// DecodeBytes, and requires that the decoded length is exactly len(dst). Use
+// this method to decode fixed-size keys and hashes. This method returns a
+// *LengthError if the decoded length differs. The content of dst is
+// unspecified on error.
+func (enc *Encoding) DecodeInto(dst, src []byte) error {
+	for k, c := range src {
+		if enc.decodeMap[c] < 0 {
+			return newCorruptInputError("base58", src, k)
+		}
+	}
+	var zerocnt int
+	for zerocnt < len(src) && src[zerocnt] == enc.alphabet[0] {
+		zerocnt++
+	}
+	clear(dst)
+	i := len(dst)
+	for _, c := range src[zerocnt:] {
+		carry := uint64(enc.decodeMap[c])
+		j := len(dst)
+		for ; j > i || carry > 0; j-- {
+			if j <= zerocnt {
+				x := base58Converter.parse(src[zerocnt:], &enc.decodeMap)
+				return &LengthError{zerocnt + (x.BitLen()+7)/8, len(dst)}
+			}
+			carry += uint64(dst[j-1]) * radix
+			dst[j-1], carry = byte(carry), carry>>8
+		}
+		i = j
+	}
+	if i != zerocnt {
+		return &LengthError{zerocnt + len(dst) - i, len(dst)}
 	}
 	return nil
 }
 
-func parseUint64(src []byte) (uint64, error) {
+func parseUint64(src []byte) (uint64, bool) {
 	var n uint64
 	for _, c := range src {
 		if '0' <= c && c <= '9' {
 			n = n*10 + uint64(c&0xF)
 		} else {
-			return 0, encodeError(src)
+			return 0, false
 		}
 	}
-	return n, nil
+	return n, true
 }
@@ -1,9 +1,14 @@
 package base58
 
 import (
+	"bytes"
+	"encoding/hex"
+	"errors"
 	"math"
+	"math/big"
 	"math/rand"
 	"strconv"
+	"strings"
 	"testing"
 )
 
@@ -86,6 +91,111 @@ var testcases = []testcase{
 	}},
 }
 
+var bytesTestcases = []testcase{
+	{FlickrEncoding, []testpair{
+		{"", ""},
+		{"00", "1"},
+		{"0000", "11"},
+		{"61", "2F"},
+		{"626262", "z3Fu"},
+		{"636363", "zoeR"},
+		{"73696d706c792061206c6f6e6720737472696e67", "2BfUPJGMeSrM59QhwSTLj2EEPkV2"},
+		{"00eb15231dfceb60925886b67d065299925915aeb172c06647", "1nr17HzF9JiFshd1uwJVkceMyUp3Ride9k"},
+		{"516b6fcd0f", "abMksLF"},
+		{"bf4f89001e670274dd", "3reN3kvkNoMTc"},
+		{"572e4794", "3eft7L"},
+		{"ecac89cad93923c02321", "eidm8CREwa6UYa"},
+		{"10c8511e", "qT5ZL"},
+		{"00000000000000000000", "1111111111"},
+		{"000111d38e5fc9071ffcd20b4a763cc9ae4f252bb4e48fd66a835e252ada93ff480d6dd43dc62a641155a5", "123456789abcdefghijkmnopqrstuvwxyzABCDEFGHJKLMNPQRSTUVWXYZ"},
+		{"ffffffffffffffffffffffffffffffff", "xBuEXKpA6iqZQK5Kf2TnkV"},
+	}},
+	{RippleEncoding, []testpair{
+		{"", ""},
+		{"00", "r"},
+		{"0000", "rr"},
+		{"61", "pg"},
+		{"626262", "2sgV"},
+		{"636363", "2PNi"},
+		{"73696d706c792061206c6f6e6720737472696e67", "pcEuFj68N1S8n9qHX1tmKpCCFLvp"},
+		{"00eb15231dfceb60925886b67d065299925915aeb172c06647", "r4Srf52g9jJgTHDrVXjvLUN8ZuQsiJDN9L"},
+		{"516b6fcd0f", "wB8LTmg"},
+		{"bf4f89001e670274dd", "sSNosLWLoP8tU"},
+		{"572e4794", "sNE7fm"},
+		{"ecac89cad93923c02321", "NJDM3diCXwauyw"},
+		{"10c8511e", "Rtnzm"},
+		{"00000000000000000000", "rrrrrrrrrr"},
+		{"000111d38e5fc9071ffcd20b4a763cc9ae4f252bb4e48fd66a835e252ada93ff480d6dd43dc62a641155a5", "rpshnaf39wBUDNEGHJKLM4PQRST7VWXYZ2bcdeCg65jkm8oFqi1tuvAxyz"},
+		{"ffffffffffffffffffffffffffffffff", "YcVCxkQbaJRzqknkEpt4Lv"},
+	}},
+	{BitcoinEncoding, []testpair{
+		{"", ""},
+		{"00", "1"},
+		{"0000", "11"},
+		{"61", "2g"},
+		{"626262", "a3gV"},
+		{"636363", "aPEr"},
+		{"73696d706c792061206c6f6e6720737472696e67", "2cFupjhnEsSn59qHXstmK2ffpLv2"},
+		{"00eb15231dfceb60925886b67d065299925915aeb172c06647", "1NS17iag9jJgTHD1VXjvLCEnZuQ3rJDE9L"},
+		{"516b6fcd0f", "ABnLTmg"},
+		{"bf4f89001e670274dd", "3SEo3LWLoPntC"},
+		{"572e4794", "3EFU7m"},
+		{"ecac89cad93923c02321", "EJDM8drfXA6uyA"},
+		{"10c8511e", "Rt5zm"},
+		{"00000000000000000000", "1111111111"},
+		{"000111d38e5fc9071ffcd20b4a763cc9ae4f252bb4e48fd66a835e252ada93ff480d6dd43dc62a641155a5", "123456789ABCDEFGHJKLMNPQRSTUVWXYZabcdefghijkmnopqrstuvwxyz"},
+		{"ffffffffffffffffffffffffffffffff", "YcVfxkQb6JRzqk5kF2tNLv"},
+	}},
+}
+
+func TestNewEncoding(t *testing.T) {
+	testCases := []struct {
+		alphabet string
+		err      string
+	}{
+		{
+			alphabet: "123456789ABCDEFGHJKLMNPQRSTUVWXYZabcdefghijkmnopqrstuvwxyz",
+		},
+		{
+			alphabet: "0123456789ABCDEFGHJKLMNPQRSTUVWXYZabcdefghijkmnopqrstuvwxyz",
+			err:      "invalid base58 alphabet length: expected 58 but got 59",
+		},
+		{
+			alphabet: "123456789",
+			err:      "invalid base58 alphabet length: expected 58 but got 9",
+		},
+		{
+			alphabet: "123456789ABCDEFGHJKLMNPQRSTUVWXYZabcdefghijkmnopqrstuvwxy1",
+			err:      "duplicate character '1' at offsets 0 and 57 in base58 alphabet",
+		},
+		{
+			alphabet: "123456789ABCDEFGHJKLMNPQRSTUVWXYZabcdefghijkmnopqrstuvwxy ",
+			err:      "invalid character ' ' at offset 57 in base58 alphabet",
+		},
+		{
+			alphabet: "123456789ABCDEFGHJKLMNPQRSTUVWXYZ\x00bcdefghijkmnopqrstuvwxyz",
+			err:      "invalid character '\\x00' at offset 33 in base58 alphabet",
+		},
+	}
+	for _, tc := range testCases {
+		enc, err := NewEncoding([]byte(tc.alphabet))
+		if tc.err == "" {
+			if err != nil {
+				t.Fatalf("Error occurred while creating an encoding %q (%s).", tc.alphabet, err)
+			}
+			if got := string(enc.EncodeUint64(57)); got != "z" {
+				t.Errorf("EncodeUint64(57) = %s, want %s", got, "z")
+			}
+		} else {
+			if err == nil {
+				t.Errorf("Error should occur while creating an encoding %q.", tc.alphabet)
+			} else if got := err.Error(); got != tc.err {
+				t.Errorf("expected: %v\ngot: %v", tc.err, got)
+			}
+		}
+	}
+}
+
 func TestEncode(t *testing.T) {
 	for _, testcase := range testcases {
 		for _, pair := range testcase.testpairs {
@@ -116,6 +226,66 @@ func TestEncodeUint64(t *testing.T) {
 	}
 }
 
+func TestAppendEncode(t *testing.T) {
+	for _, testcase := range testcases {
+		for _, pair := range testcase.testpairs {
+			got, err := testcase.encoding.AppendEncode([]byte("prefix:"), []byte(pair.decoded))
+			if err != nil {
+				t.Fatalf("Error occurred while encoding %s (%s).", pair.decoded, err)
+			}
+			if expected := "prefix:" + pair.encoded; string(got) != expected {
+				t.Errorf("AppendEncode(%s) = %s, want %s", pair.decoded, got, expected)
+			}
+			if n := EncodedLen(len(pair.decoded)); len(pair.encoded) > n {
+				t.Errorf("EncodedLen(%d) = %d, want >= %d", len(pair.decoded), n, len(pair.encoded))
+			}
+		}
+	}
+}
+
+func TestAppendEncodeUint64(t *testing.T) {
+	for _, testcase := range testcases {
+		for i := range 100 {
+			n := rand.Uint64() % uint64(math.Pow10(int(i/5)))
+			got := testcase.encoding.AppendEncodeUint64([]byte("prefix:"), n)
+			expected := "prefix:" + string(testcase.encoding.EncodeUint64(n))
+			if string(got) != expected {
+				t.Errorf("AppendEncodeUint64(%d) = %s, want %s", n, got, expected)
+			}
+		}
+	}
+}
+
+func TestAppendDecode(t *testing.T) {
+	for _, testcase := range testcases {
+		for _, pair := range testcase.testpairs {
+			got, err := testcase.encoding.AppendDecode([]byte("prefix:"), []byte(pair.encoded))
+			if err != nil {
+				t.Fatalf("Error occurred while decoding %s (%s).", pair.encoded, err)
+			}
+			if expected := "prefix:" + pair.decoded; string(got) != expected {
+				t.Errorf("AppendDecode(%s) = %s, want %s", pair.encoded, got, expected)
+			}
+			if n := DecodedLen(len(pair.encoded)); len(pair.decoded) > n {
+				t.Errorf("DecodedLen(%d) = %d, want >= %d", len(pair.encoded), n, len(pair.decoded))
+			}
+		}
+	}
+}
+
+func TestAppend_Allocs(t *testing.T) {
+	buf := make([]byte, 0, 64)
+	decoded, encoded := []byte("18446744073709551615"), []byte("JPwcyDCgEup")
+	allocs := testing.AllocsPerRun(100, func() {
+		buf, _ = FlickrEncoding.AppendEncode(buf[:0], decoded)
+		buf = FlickrEncoding.AppendEncodeUint64(buf[:0], math.MaxUint64)
+		buf, _ = FlickrEncoding.AppendDecode(buf[:0], encoded)
+	})
+	if allocs != 0 {
+		t.Errorf("expected no allocation but got %v", allocs)
+	}
+}
+
 func TestDecode(t *testing.T) {
 	for _, testcase := range testcases {
 		for _, pair := range testcase.testpairs {
@@ -170,6 +340,310 @@ func TestDecodeUint64_Overflow(t *testing.T) {
 	}
 }
 
+func TestEncodeBytes(t *testing.T) {
+	for _, testcase := range bytesTestcases {
+		for _, pair := range testcase.testpairs {
+			src, _ := hex.DecodeString(pair.decoded)
+			got := testcase.encoding.EncodeBytes(src)
+			if string(got) != pair.encoded {
+				t.Errorf("EncodeBytes(%s) = %s, want %s", pair.decoded, string(got), pair.encoded)
+			}
+		}
+	}
+}
+
+func TestDecodeBytes(t *testing.T) {
+	for _, testcase := range bytesTestcases {
+		for _, pair := range testcase.testpairs {
+			got, err := testcase.encoding.DecodeBytes([]byte(pair.encoded))
+			if err != nil {
+				t.Fatalf("Error occurred while decoding %s (%s).", pair.encoded, err)
+			}
+			if hex.EncodeToString(got) != pair.decoded {
+				t.Errorf("DecodeBytes(%s) = %x, want %s", pair.encoded, got, pair.decoded)
+			}
+		}
+	}
+}
+
+func TestDecodeBytes_Error(t *testing.T) {
+	for _, testcase := range bytesTestcases {
+		for _, src := range []string{"0", "O", "I", "l", "1 ", "2g\n"} {
+			got, err := testcase.encoding.DecodeBytes([]byte(src))
+			if err == nil {
+				t.Errorf("Error should occur while decoding %q but got %x.", src, got)
+			}
+		}
+	}
+}
+
+func TestDecodeInto(t *testing.T) {
+	for _, testcase := range bytesTestcases {
+		for _, pair := range testcase.testpairs {
+			got := bytes.Repeat([]byte{0xff}, len(pair.decoded)/2)
+			if err := testcase.encoding.DecodeInto(got, []byte(pair.encoded)); err != nil {
+				t.Fatalf("Error occurred while decoding %s (%s).", pair.encoded, err)
+			}
+			if hex.EncodeToString(got) != pair.decoded {
+				t.Errorf("DecodeInto(%s) = %x, want %s", pair.encoded, got, pair.decoded)
+			}
+		}
+	}
+	var key [32]byte
+	if err := BitcoinEncoding.DecodeInto(key[:], []byte("TokenkegQfeZyiNwAJbNbGKPFXCWuBvf9Ss623VQ5DA")); err != nil {
+		t.Fatalf("Error occurred while decoding (%s).", err)
+	}
+	if got, expected := hex.EncodeToString(key[:]),
+		"06ddf6e1d765a193d9cbe146ceeb79ac1cb485ed5f5b37913a8cf5857eff00a9"; got != expected {
+		t.Errorf("DecodeInto(%s) = %s, want %s", "TokenkegQfeZyiNwAJbNbGKPFXCWuBvf9Ss623VQ5DA", got, expected)
+	}
+	if err := BitcoinEncoding.DecodeInto(key[:], bytes.Repeat([]byte("1"), 32)); err != nil || key != [32]byte{} {
+		t.Errorf("DecodeInto should decode to zeros but got %x (%v).", key, err)
+	}
+}
+
+func TestDecodeInto_Error(t *testing.T) {
+	testCases := []struct {
+		src      string
+		size     int
+		expected string
+	}{
+		{"", 1, `invalid length 0 in decoding a base58 string (expected 1)`},
+		{"5Q", 2, `invalid length 1 in decoding a base58 string (expected 2)`},
+		{"15R", 1, `invalid length 3 in decoding a base58 string (expected 1)`},
+		{"LUv", 1, `invalid length 2 in decoding a base58 string (expected 1)`},
+		{"11LUv", 3, `invalid length 4 in decoding a base58 string (expected 3)`},
+		{"111", 2, `invalid length 3 in decoding a base58 string (expected 2)`},
+		{"115Q", 4, `invalid length 3 in decoding a base58 string (expected 4)`},
+		{"1TokenkegQfeZyiNwAJbNbGKPFXCWuBvf9Ss623VQ5DA", 32,
+			`invalid length 33 in decoding a base58 string (expected 32)`},
+		{"11l", 2, `invalid character 'l' at offset 2 in decoding a base58 string "11l"`},
+		{"zzzzzzzzzzzz0", 2, `invalid character '0' at offset 12 in decoding a base58 string "zzzzzzzzzzzz0"`},
+	}
+	for _, tc := range testCases {
+		err := BitcoinEncoding.DecodeInto(make([]byte, tc.size), []byte(tc.src))
+		if err == nil || err.Error() != tc.expected {
+			t.Errorf("expected: %v\ngot: %v", tc.expected, err)
+		}
+	}
+	err := BitcoinEncoding.DecodeInto(make([]byte, 32), []byte("1TokenkegQfeZyiNwAJbNbGKPFXCWuBvf9Ss623VQ5DA"))
+	var lerr *LengthError
+	if !errors.As(err, &lerr) || lerr.Length != 33 || lerr.Expected != 32 {
+		t.Errorf("expected LengthError of length 33 but got: %#v", err)
+	}
+	for i := range 100 {
+		src := make([]byte, i)
+		rand.Read(src)
+		encoded := BitcoinEncoding.EncodeBytes(src)
+		for _, size := range []int{0, i / 2, i + 1} {
+			err := BitcoinEncoding.DecodeInto(make([]byte, size), encoded)
+			if size != i && (!errors.As(err, &lerr) || lerr.Length != i || lerr.Expected != size) {
+				t.Errorf("expected LengthError of length %d but got: %#v", i, err)
+			}
+		}
+	}
+	err = BitcoinEncoding.DecodeInto(make([]byte, 2), []byte("11l"))
+	if errors.As(err, &lerr) {
+		t.Errorf("expected CorruptInputError but got: %#v", err)
+	}
+}
+
+func TestEncodeDecode_Large(t *testing.T) {
+	defer func(threshold int) { bigThreshold = threshold }(bigThreshold)
+	for _, testcase := range testcases {
+		for _, n := range []int{100, 399, 400, 401, 1000, 10000} {
+			src := randomDigits(n)
+			copy(src, "00")
+			bigThreshold = math.MaxInt
+			expected, err := testcase.encoding.Encode(src)
+			if err != nil {
+				t.Fatalf("Error occurred while encoding %s (%s).", src, err)
+			}
+			bigThreshold = 100
+			got, err := testcase.encoding.Encode(src)
+			if err != nil {
+				t.Fatalf("Error occurred while encoding %s (%s).", src, err)
+			}
+			if string(got) != string(expected) {
+				t.Errorf("Encode(%s) = %s, want %s", src, got, expected)
+			}
+			got, err = testcase.encoding.Decode(expected)
+			if err != nil {
+				t.Fatalf("Error occurred while decoding %s (%s).", expected, err)
+			}
+			if string(got) != string(src) {
+				t.Errorf("Decode(%s) = %s, want %s", expected, got, src)
+			}
+		}
+	}
+}
+
+func randomDigits(n int) []byte {
+	src := make([]byte, n)
+	for i := range src {
+		src[i] = byte('0' + rand.Intn(10))
+	}
+	return src
+}
+
+func TestErrors(t *testing.T) {
+	long := []byte(strings.Repeat("1", 100) + "O" + strings.Repeat("2", 100))
+	testCases := []struct {
+		f   func() error
+		err string
+	}{
+		{
+			func() error { _, err := FlickrEncoding.Encode([]byte("12x4")); return err },
+			`invalid character 'x' at offset 2 in encoding a base10 number "12x4"`,
+		},
+		{
+			func() error { _, err := FlickrEncoding.Encode([]byte(strings.Repeat("9", 100) + "-")); return err },
+			`invalid character '-' at offset 100 in encoding a base10 number ...` +
+				`"9999999999999999999999999999999-"`,
+		},
+		{
+			func() error { _, err := FlickrEncoding.Decode([]byte("11O4")); return err },
+			`invalid character 'O' at offset 2 in decoding a base58 string "11O4"`,
+		},
+		{
+			func() error { _, err := FlickrEncoding.Decode(long); return err },
+			`invalid character 'O' at offset 100 in decoding a base58 string ...` +
+				`"1111111111111111O222222222222222"...`,
+		},
+		{
+			func() error {
+				_, err := FlickrEncoding.Decode([]byte("11111" + strings.Repeat("2", 500) + "O"))
+				return err
+			},
+			`invalid character 'O' at offset 505 in decoding a base58 string ...` +
+				`"2222222222222222222222222222222O"`,
+		},
+		{
+			func() error { _, err := FlickrEncoding.DecodeUint64([]byte("\x00")); return err },
+			`invalid character '\x00' at offset 0 in decoding a base58 string "\x00"`,
+		},
+		{
+			func() error { _, err := FlickrEncoding.DecodeBytes([]byte("11l")); return err },
+			`invalid character 'l' at offset 2 in decoding a base58 string "11l"`,
+		},
+		{
+			func() error { _, err := FlickrEncoding.DecodeUint64([]byte("aaaaaaaaaaaaaa")); return err },
+			`overflow of uint64 in decoding a base58 string "aaaaaaaaaaaaaa"`,
+		},
+	}
+	for _, tc := range testCases {
+		err := tc.f()
+		if err == nil {
+			t.Fatalf("Error should occur: %s", tc.err)
+		}
+		if got := err.Error(); got != tc.err {
+			t.Errorf("expected: %v\ngot: %v", tc.err, got)
+		}
+	}
+	_, err := FlickrEncoding.Decode(long)
+	var cerr *CorruptInputError
+	if !errors.As(err, &cerr) || cerr.Char != 'O' || cerr.Offset != 100 {
+		t.Errorf("expected CorruptInputError at offset 100 but got: %#v", err)
+	}
+	if got, expected := cerr.Redact().Error(),
+		"invalid character at offset 100 in decoding a base58 string"; got != expected {
+		t.Errorf("expected: %v\ngot: %v", expected, got)
+	}
+	if got := cerr.Redact(); got.Char != 0 || got.Offset != 100 {
+		t.Errorf("expected redacted CorruptInputError at offset 100 but got: %#v", got)
+	}
+	_, err = FlickrEncoding.Encode([]byte("12x4"))
+	var derr *InvalidDigitError
+	if !errors.As(err, &derr) || derr.Char != 'x' || derr.Offset != 2 {
+		t.Errorf("expected InvalidDigitError at offset 2 but got: %#v", err)
+	}
+	_, err = FlickrEncoding.DecodeUint64([]byte("aaaaaaaaaaaaaa"))
+	if !errors.Is(err, ErrOverflow) {
+		t.Errorf("expected: %v\ngot: %v", ErrOverflow, err)
+	}
+}
+
+func TestDecodeUint64_Wraparound(t *testing.T) {
+	for _, testcase := range testcases {
+		src := bytes.Repeat(testcase.encoding.alphabet[57:], 11) // 58^11-1
+		got, err := testcase.encoding.DecodeUint64(src)
+		if !errors.Is(err, ErrOverflow) {
+			t.Errorf("Overflow error should occur while decoding %s but got %d.", src, got)
+		}
+	}
+}
+
+func TestDecodeUint_Boundary(t *testing.T) {
+	for _, testcase := range testcases {
+		enc := testcase.encoding
+		for k, pow := 1, uint64(58); k <= 10; k, pow = k+1, pow*58 {
+			for _, n := range []uint64{pow - 2, pow - 1, pow, pow + 1} {
+				src := enc.EncodeUint64(n)
+				if expected := k + int(n/pow); len(src) != expected {
+					t.Errorf("len(EncodeUint64(%d)) = %d, want %d", n, len(src), expected)
+				}
+				got, err := enc.DecodeUint64(src)
+				if err != nil {
+					t.Fatalf("Error occurred while decoding %s (%s).", src, err)
+				}
+				if got != n {
+					t.Errorf("DecodeUint64(%s) = %d, want %d", src, got, n)
+				}
+				got32, err := enc.DecodeUint32(src)
+				if n <= math.MaxUint32 {
+					if err != nil {
+						t.Fatalf("Error occurred while decoding %s (%s).", src, err)
+					}
+					if uint64(got32) != n {
+						t.Errorf("DecodeUint32(%s) = %d, want %d", src, got32, n)
+					}
+				} else if !errors.Is(err, ErrOverflow) {
+					t.Errorf("Overflow error should occur while decoding %s but got %d.", src, got32)
+				}
+				got16, err := enc.DecodeUint16(src)
+				if n <= math.MaxUint16 {
+					if err != nil {
+						t.Fatalf("Error occurred while decoding %s (%s).", src, err)
+					}
+					if uint64(got16) != n {
+						t.Errorf("DecodeUint16(%s) = %d, want %d", src, got16, n)
+					}
+				} else if !errors.Is(err, ErrOverflow) {
+					t.Errorf("Overflow error should occur while decoding %s but got %d.", src, got16)
+				}
+			}
+		}
+		for _, n := range []uint64{math.MaxUint16, math.MaxUint32, math.MaxUint64} {
+			src := enc.EncodeUint64(n)
+			bs := new(big.Int).SetUint64(n)
+			next := enc.EncodeBigInt(bs.Add(bs, big.NewInt(1)))
+			switch n {
+			case math.MaxUint16:
+				if got, err := enc.DecodeUint16(src); err != nil || got != math.MaxUint16 {
+					t.Errorf("DecodeUint16(%s) = %d, %v, want %d", src, got, err, n)
+				}
+				if got, err := enc.DecodeUint16(next); !errors.Is(err, ErrOverflow) {
+					t.Errorf("Overflow error should occur while decoding %s but got %d.", next, got)
+				}
+			case math.MaxUint32:
+				if got, err := enc.DecodeUint32(src); err != nil || got != math.MaxUint32 {
+					t.Errorf("DecodeUint32(%s) = %d, %v, want %d", src, got, err, n)
+				}
+				if got, err := enc.DecodeUint32(next); !errors.Is(err, ErrOverflow) {
+					t.Errorf("Overflow error should occur while decoding %s but got %d.", next, got)
+				}
+			case math.MaxUint64:
+				if got, err := enc.DecodeUint64(src); err != nil || got != math.MaxUint64 {
+					t.Errorf("DecodeUint64(%s) = %d, %v, want %d", src, got, err, n)
+				}
+				if got, err := enc.DecodeUint64(next); !errors.Is(err, ErrOverflow) {
+					t.Errorf("Overflow error should occur while decoding %s but got %d.", next, got)
+				}
+			}
+		}
+	}
+}
+
 func BenchmarkEncode(b *testing.B) {
 	for range b.N {
 		for _, testcase := range testcases {
@@ -189,3 +663,37 @@ func BenchmarkDecode(b *testing.B) {
 		}
 	}
 }
+
+func BenchmarkEncode_Large(b *testing.B) {
+	defer func(threshold int) { bigThreshold = threshold }(bigThreshold)
+	for _, n := range []int{100, 200, 300, 500, 1000, 10000, 100000} {
+		src := randomDigits(n)
+		for _, name := range []string{"quadratic", "subquadratic"} {
+			b.Run(strconv.Itoa(n)+"/"+name, func(b *testing.B) {
+				if bigThreshold = math.MaxInt; name == "subquadratic" {
+					bigThreshold = 0
+				}
+				for range b.N {
+					_, _ = BitcoinEncoding.Encode(src)
+				}
+			})
+		}
+	}
+}
+
+func BenchmarkDecode_Large(b *testing.B) {
+	defer func(threshold int) { bigThreshold = threshold }(bigThreshold)
+	for _, n := range []int{100, 200, 300, 500, 1000, 10000, 100000} {
+		src, _ := BitcoinEncoding.Encode(randomDigits(n))
+		for _, name := range []string{"quadratic", "subquadratic"} {
+			b.Run(strconv.Itoa(n)+"/"+name, func(b *testing.B) {
+				if bigThreshold = math.MaxInt; name == "subquadratic" {
+					bigThreshold = 0
+				}
+				for range b.N {
+					_, _ = BitcoinEncoding.Decode(src)
+				}
+			})
+		}
+	}
+}
@@ -0,0 +1,22 @@
+package base58
+
+import "math/big"
+
+// EncodeBigInt encodes the absolute value of the big integer. The encoded
+// bytes have no leading first character of the alphabet, except that zero is
+// encoded to the first character.
+func (enc *Encoding) EncodeBigInt(x *big.Int) []byte {
+	return trimLeadingZeros(base58Converter.format(x, enc.alphabet[:]), enc.alphabet[0])
+}
+
+// DecodeBigInt decodes the base58 encoded bytes to a big integer. Leading
+// first characters of the alphabet do not affect the value, and empty bytes
+// are decoded to zero.
+func (enc *Encoding) DecodeBigInt(src []byte) (*big.Int, error) {
+	for i, c := range src {
+		if enc.decodeMap[c] < 0 {
+			return nil, newCorruptInputError("base58", src, i)
+		}
+	}
+	return base58Converter.parse(src, &enc.decodeMap), nil
+}
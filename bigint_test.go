@@ -0,0 +1,99 @@
+package base58
+
+import (
+	"math/big"
+	"math/rand"
+	"strings"
+	"testing"
+)
+
+func TestEncodeBigInt(t *testing.T) {
+	for _, testcase := range testcases {
+		for _, pair := range testcase.testpairs {
+			x, ok := new(big.Int).SetString(pair.decoded, 10)
+			if !ok {
+				continue
+			}
+			got := testcase.encoding.EncodeBigInt(x)
+			expected := strings.TrimLeft(pair.encoded, string(testcase.encoding.alphabet[:1]))
+			if expected == "" {
+				expected = string(testcase.encoding.alphabet[:1])
+			}
+			if string(got) != expected {
+				t.Errorf("EncodeBigInt(%s) = %s, want %s", pair.decoded, got, expected)
+			}
+		}
+	}
+}
+
+func TestDecodeBigInt(t *testing.T) {
+	for _, testcase := range testcases {
+		for _, pair := range testcase.testpairs {
+			got, err := testcase.encoding.DecodeBigInt([]byte(pair.encoded))
+			if err != nil {
+				t.Fatalf("Error occurred while decoding %s (%s).", pair.encoded, err)
+			}
+			expected, _ := new(big.Int).SetString("0"+pair.decoded, 10)
+			if got.Cmp(expected) != 0 {
+				t.Errorf("DecodeBigInt(%s) = %s, want %s", pair.encoded, got, expected)
+			}
+		}
+	}
+}
+
+func TestBigInt_Random(t *testing.T) {
+	for _, testcase := range testcases {
+		for i := range 100 {
+			x := new(big.Int).Rand(rand.New(rand.NewSource(int64(i))),
+				new(big.Int).Lsh(big.NewInt(1), uint(i*20)))
+			got := testcase.encoding.EncodeBigInt(x)
+			expected, err := testcase.encoding.Encode([]byte(x.String()))
+			if err != nil {
+				t.Fatalf("Error occurred while encoding %s (%s).", x, err)
+			}
+			if string(got) != string(expected) {
+				t.Errorf("EncodeBigInt(%s) = %s, want %s", x, got, expected)
+			}
+			y, err := testcase.encoding.DecodeBigInt(got)
+			if err != nil {
+				t.Fatalf("Error occurred while decoding %s (%s).", got, err)
+			}
+			if y.Cmp(x) != 0 {
+				t.Errorf("DecodeBigInt(%s) = %s, want %s", got, y, x)
+			}
+		}
+	}
+}
+
+func TestBigInt_Huge(t *testing.T) {
+	x := new(big.Int).Rand(rand.New(rand.NewSource(0)), new(big.Int).Lsh(big.NewInt(1), 1<<20))
+	got := BitcoinEncoding.EncodeBigInt(x)
+	y, err := BitcoinEncoding.DecodeBigInt(got)
+	if err != nil {
+		t.Fatalf("Error occurred while decoding (%s).", err)
+	}
+	if y.Cmp(x) != 0 {
+		t.Errorf("DecodeBigInt(EncodeBigInt(x)) != x for a number of %d bits", x.BitLen())
+	}
+	expected, err := BitcoinEncoding.Encode([]byte(x.String()))
+	if err != nil {
+		t.Fatalf("Error occurred while encoding (%s).", err)
+	}
+	if string(got) != string(expected) {
+		t.Errorf("EncodeBigInt(x) != Encode(x) for a number of %d bits", x.BitLen())
+	}
+	for _, c := range []*bigConverter{base58Converter, base10Converter} {
+		if got := len(c.pows); got > maxCachedPowers {
+			t.Errorf("expected at most %d cached powers but got %d", maxCachedPowers, got)
+		}
+	}
+}
+
+func TestDecodeBigInt_Error(t *testing.T) {
+	for _, testcase := range testcases {
+		src := []byte("2222222222222222O")
+		if got, err := testcase.encoding.DecodeBigInt(src); err == nil {
+			t.Errorf("Error should occur while decoding %s but got %s.", src, got)
+		}
+	}
+}
@@ -0,0 +1,133 @@
+// Package bitcoin implements the legacy Bitcoin addresses, which are encoded
+// in base58check with BitcoinEncoding.
+package bitcoin
+
+import (
+	"crypto/sha256"
+	"fmt"
+
+	"github.com/itchyny/base58-go"
+	"github.com/itchyny/base58-go/internal/ripemd160"
+)
+
+// A Network is the parameter set of a Bitcoin network.
+type Network struct {
+	Name              string
+	PubKeyHashVersion byte // version of P2PKH addresses
+	ScriptHashVersion byte // version of P2SH addresses
+	PrivateKeyVersion byte // version of WIF private keys
+}
+
+var (
+	// Mainnet is the parameter set of the main network.
+	Mainnet = &Network{Name: "mainnet",
+		PubKeyHashVersion: 0x00, ScriptHashVersion: 0x05, PrivateKeyVersion: 0x80}
+	// Testnet is the parameter set of the test network.
+	Testnet = &Network{Name: "testnet",
+		PubKeyHashVersion: 0x6f, ScriptHashVersion: 0xc4, PrivateKeyVersion: 0xef}
+	// Regtest is the parameter set of the regression test network, which has
+	// the same version bytes as Testnet.
+	Regtest = &Network{Name: "regtest",
+		PubKeyHashVersion: 0x6f, ScriptHashVersion: 0xc4, PrivateKeyVersion: 0xef}
+)
+
+// networks are the networks looked up in decoding addresses and keys.
+var networks = []*Network{Mainnet, Testnet}
+
+// An AddressType is the type of a legacy address.
+type AddressType int
+
+const (
+	// P2PKH is the type of pay-to-pubkey-hash addresses.
+	P2PKH AddressType = iota
+	// P2SH is the type of pay-to-script-hash addresses.
+	P2SH
+)
+
+func (typ AddressType) String() string {
+	switch typ {
+	case P2PKH:
+		return "P2PKH"
+	case P2SH:
+		return "P2SH"
+	default:
+		return fmt.Sprintf("AddressType(%d)", int(typ))
+	}
+}
+
+// HashSize is the size of the hash of an address.
+const HashSize = ripemd160.Size
+
+// An Address is a legacy Bitcoin address.
+type Address struct {
+	Network *Network
+	Type    AddressType
+	Hash    [HashSize]byte
+}
+
+// Hash160 returns the RIPEMD-160 hash of the SHA-256 hash of the data, which is
+// the hash of a public key or a script in the addresses.
+func Hash160(data []byte) [HashSize]byte {
+	h := sha256.Sum256(data)
+	return ripemd160.Sum(h[:])
+}
+
+// NewAddressPubKeyHash returns the P2PKH address of the hash160 of a public key.
+func NewAddressPubKeyHash(hash [HashSize]byte, net *Network) *Address {
+	return &Address{Network: net, Type: P2PKH, Hash: hash}
+}
+
+// NewAddressPubKey returns the P2PKH address of the serialized public key.
+func NewAddressPubKey(pubKey []byte, net *Network) *Address {
+	return NewAddressPubKeyHash(Hash160(pubKey), net)
+}
+
+// NewAddressScriptHash returns the P2SH address of the hash160 of a script.
+func NewAddressScriptHash(hash [HashSize]byte, net *Network) *Address {
+	return &Address{Network: net, Type: P2SH, Hash: hash}
+}
+
+func (net *Network) version(typ AddressType) byte {
+	if typ == P2SH {
+		return net.ScriptHashVersion
+	}
+	return net.PubKeyHashVersion
+}
+
+// Version returns the version byte of the address.
+func (addr *Address) Version() byte {
+	return addr.Network.version(addr.Type)
+}
+
+// IsForNetwork reports whether the address is valid on the network. Note that
+// the addresses of Testnet are valid on Regtest, and vice versa.
+func (addr *Address) IsForNetwork(net *Network) bool {
+	return addr.Version() == net.version(addr.Type)
+}
+
+// String returns the base58check encoding of the address.
+func (addr *Address) String() string {
+	return string(base58.BitcoinEncoding.CheckEncode([]byte{addr.Version()}, addr.Hash[:]))
+}
+
+// DecodeAddress decodes the legacy Bitcoin address, and reports the network
+// and the address type by the version byte. The addresses of Regtest are
+// reported as of Testnet since they have the same version bytes.
+func DecodeAddress(addr string) (*Address, error) {
+	version, hash, err := base58.BitcoinEncoding.CheckDecode([]byte(addr), 1)
+	if err != nil {
+		return nil, err
+	}
+	if len(hash) != HashSize {
+		return nil, fmt.Errorf("invalid hash length %d in decoding a bitcoin address (expected %d)",
+			len(hash), HashSize)
+	}
+	for _, net := range networks {
+		for _, typ := range []AddressType{P2PKH, P2SH} {
+			if version[0] == net.version(typ) {
+				return &Address{Network: net, Type: typ, Hash: [HashSize]byte(hash)}, nil
+			}
+		}
+	}
+	return nil, fmt.Errorf("unknown version %02x in decoding a bitcoin address", version[0])
+}
@@ -0,0 +1,69 @@
+package bitcoin
+
+import (
+	"encoding/hex"
+	"errors"
+	"testing"
+
+	"github.com/itchyny/base58-go"
+)
+
+func TestAddress(t *testing.T) {
+	hash, _ := hex.DecodeString("f54a5851e9372b87810a8e60cdd2e7cfd80b6e31")
+	testCases := []struct {
+		addr     *Address
+		expected string
+		network  *Network
+	}{
+		{NewAddressPubKeyHash([HashSize]byte(hash), Mainnet), "1PMycacnJaSqwwJqjawXBErnLsZ7RkXUAs", Mainnet},
+		{NewAddressScriptHash([HashSize]byte(hash), Mainnet), "3Q3zY87DrUmE371Grgc7bsDiVPqpu4mN1f", Mainnet},
+		{NewAddressPubKeyHash([HashSize]byte(hash), Testnet), "n3svudhm7bt6j3nTT9uu1A57Cs9pKK3iXW", Testnet},
+		{NewAddressScriptHash([HashSize]byte(hash), Testnet), "2NFcCbs3FTwGaEtdpXpDzDpCyhk3znhQzzo", Testnet},
+		{NewAddressPubKeyHash([HashSize]byte(hash), Regtest), "n3svudhm7bt6j3nTT9uu1A57Cs9pKK3iXW", Testnet},
+		{NewAddressScriptHash([HashSize]byte(hash), Regtest), "2NFcCbs3FTwGaEtdpXpDzDpCyhk3znhQzzo", Testnet},
+	}
+	for _, tc := range testCases {
+		if got := tc.addr.String(); got != tc.expected {
+			t.Errorf("%s address on %s = %s, want %s", tc.addr.Type, tc.addr.Network.Name, got, tc.expected)
+		}
+		addr, err := DecodeAddress(tc.expected)
+		if err != nil {
+			t.Fatalf("Error occurred while decoding %s (%s).", tc.expected, err)
+		}
+		if addr.Network != tc.network || addr.Type != tc.addr.Type || addr.Hash != tc.addr.Hash {
+			t.Errorf("DecodeAddress(%s) = %s, %s, %x, want %s, %s, %x", tc.expected,
+				addr.Network.Name, addr.Type, addr.Hash, tc.network.Name, tc.addr.Type, tc.addr.Hash)
+		}
+		if !addr.IsForNetwork(tc.addr.Network) || addr.IsForNetwork(Mainnet) != (tc.network == Mainnet) {
+			t.Errorf("IsForNetwork of %s reports unexpected result", tc.expected)
+		}
+	}
+}
+
+func TestNewAddressPubKey(t *testing.T) {
+	pubKey, _ := hex.DecodeString("0250863ad64a87ae8a2fe83c1af1a8403cb53f53e486d8511dad8a04887e5b2352")
+	if got, expected := NewAddressPubKey(pubKey, Mainnet).String(), "1PMycacnJaSqwwJqjawXBErnLsZ7RkXUAs"; got != expected {
+		t.Errorf("NewAddressPubKey(%x) = %s, want %s", pubKey, got, expected)
+	}
+}
+
+func TestDecodeAddress_Error(t *testing.T) {
+	testCases := []struct {
+		addr     string
+		expected string
+	}{
+		{"LUEweDxDA4WhvWiNXXSxjM9CYzHPJv4QQF", "unknown version 30 in decoding a bitcoin address"},
+		{"133VQZmihsauVFjR6XTtJkHs9ZP6Nwkii", "invalid hash length 19 in decoding a bitcoin address (expected 20)"},
+		{"1A1zP1eP5QGefi2DMPTfTL5SLmv7DivfNb", base58.ErrChecksum.Error()},
+		{"1A1zP1eP5QGefi2DMPTfTL5SLmv7DivfN0", `invalid character '0' at offset 33 in decoding a base58 string ..."1zP1eP5QGefi2DMPTfTL5SLmv7DivfN0"`},
+	}
+	for _, tc := range testCases {
+		_, err := DecodeAddress(tc.addr)
+		if err == nil || err.Error() != tc.expected {
+			t.Errorf("expected: %v\ngot: %v", tc.expected, err)
+		}
+	}
+	if _, err := DecodeAddress("1A1zP1eP5QGefi2DMPTfTL5SLmv7DivfNb"); !errors.Is(err, base58.ErrChecksum) {
+		t.Errorf("expected: %v\ngot: %v", base58.ErrChecksum, err)
+	}
+}
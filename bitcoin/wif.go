@@ -0,0 +1,72 @@
+package bitcoin
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/itchyny/base58-go"
+)
+
+// PrivateKeySize is the size of a private key.
+const PrivateKeySize = 32
+
+// compressedFlag is the suffix of the WIF private keys of compressed public keys.
+const compressedFlag = 0x01
+
+// A WIF is a private key in the Wallet Import Format.
+type WIF struct {
+	Network    *Network
+	PrivateKey [PrivateKeySize]byte
+	Compressed bool // whether the key corresponds to a compressed public key
+}
+
+// NewWIF returns the WIF of the private key on the network.
+func NewWIF(privateKey [PrivateKeySize]byte, net *Network, compressed bool) *WIF {
+	return &WIF{Network: net, PrivateKey: privateKey, Compressed: compressed}
+}
+
+// Encode returns the base58check encoding of the private key. This method is
+// not named String to avoid printing the secret by accident.
+func (w *WIF) Encode() string {
+	payload := make([]byte, PrivateKeySize, PrivateKeySize+1)
+	copy(payload, w.PrivateKey[:])
+	if w.Compressed {
+		payload = append(payload, compressedFlag)
+	}
+	return string(base58.BitcoinEncoding.CheckEncode([]byte{w.Network.PrivateKeyVersion}, payload))
+}
+
+// DecodeWIF decodes the private key in the Wallet Import Format, and reports
+// the network by the version byte. The private keys of Regtest are reported
+// as of Testnet since they have the same version byte. The error messages do
+// not contain the input nor the decoded bytes.
+func DecodeWIF(wif string) (*WIF, error) {
+	version, payload, err := base58.BitcoinEncoding.CheckDecode([]byte(wif), 1)
+	if err != nil {
+		if cerr := (*base58.CorruptInputError)(nil); errors.As(err, &cerr) {
+			return nil, cerr.Redact()
+		}
+		return nil, err
+	}
+	defer clear(payload) // best-effort; the input string still holds the key
+	i := slices.IndexFunc(networks, func(net *Network) bool {
+		return net.PrivateKeyVersion == version[0]
+	})
+	if i < 0 {
+		return nil, fmt.Errorf("unknown version %02x in decoding a WIF private key", version[0])
+	}
+	switch len(payload) {
+	case PrivateKeySize:
+		return NewWIF([PrivateKeySize]byte(payload), networks[i], false), nil
+	case PrivateKeySize + 1:
+		if payload[PrivateKeySize] != compressedFlag {
+			return nil, fmt.Errorf("invalid compression flag %02x in decoding a WIF private key",
+				payload[PrivateKeySize])
+		}
+		return NewWIF([PrivateKeySize]byte(payload), networks[i], true), nil
+	default:
+		return nil, fmt.Errorf("invalid length %d in decoding a WIF private key (expected %d or %d)",
+			len(payload), PrivateKeySize, PrivateKeySize+1)
+	}
+}
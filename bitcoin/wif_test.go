@@ -0,0 +1,64 @@
+package bitcoin
+
+import (
+	"encoding/hex"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/itchyny/base58-go"
+)
+
+func TestWIF(t *testing.T) {
+	key, _ := hex.DecodeString("0c28fca386c7a227600b2fe50b7cae11ec86d3bf1fbe471be89827e19d72aa1d")
+	testCases := []struct {
+		wif      *WIF
+		expected string
+		network  *Network
+	}{
+		{NewWIF([PrivateKeySize]byte(key), Mainnet, false), "5HueCGU8rMjxEXxiPuD5BDku4MkFqeZyd4dZ1jvhTVqvbTLvyTJ", Mainnet},
+		{NewWIF([PrivateKeySize]byte(key), Mainnet, true), "KwdMAjGmerYanjeui5SHS7JkmpZvVipYvB2LJGU1ZxJwYvP98617", Mainnet},
+		{NewWIF([PrivateKeySize]byte(key), Testnet, false), "91gGn1HgSap6CbU12F6z3pJri26xzp7Ay1VW6NHCoEayNXwRpu2", Testnet},
+		{NewWIF([PrivateKeySize]byte(key), Testnet, true), "cMzLdeGd5vEqxB8B6VFQoRopQ3sLAAvEzDAoQgvX54xwofSWj1fx", Testnet},
+		{NewWIF([PrivateKeySize]byte(key), Regtest, true), "cMzLdeGd5vEqxB8B6VFQoRopQ3sLAAvEzDAoQgvX54xwofSWj1fx", Testnet},
+	}
+	for _, tc := range testCases {
+		if got := tc.wif.Encode(); got != tc.expected {
+			t.Errorf("WIF on %s (compressed: %t) = %s, want %s", tc.wif.Network.Name, tc.wif.Compressed, got, tc.expected)
+		}
+		wif, err := DecodeWIF(tc.expected)
+		if err != nil {
+			t.Fatalf("Error occurred while decoding %s (%s).", tc.expected, err)
+		}
+		if wif.Network != tc.network || wif.PrivateKey != tc.wif.PrivateKey || wif.Compressed != tc.wif.Compressed {
+			t.Errorf("DecodeWIF(%s) = %s, %x, %t, want %s, %x, %t", tc.expected,
+				wif.Network.Name, wif.PrivateKey, wif.Compressed, tc.network.Name, tc.wif.PrivateKey, tc.wif.Compressed)
+		}
+	}
+}
+
+func TestDecodeWIF_Error(t *testing.T) {
+	testCases := []struct {
+		wif      string
+		expected string
+	}{
+		{"KwdMAjGmerYanjeui5SHS7JkmpZvVipYvB2LJGU1ZxJwYvWxyf5d", "invalid compression flag 02 in decoding a WIF private key"},
+		{"yPoVP5njSzmEVK4VJGRWWAwqnwCyLPRcMm5XyrKgY1DE64xhu", "invalid length 31 in decoding a WIF private key (expected 32 or 33)"},
+		{"5KrPNVvAhnRBNMYRJUq58YMfyUMyVMQrQhhfFtcbT9rK67poC3F", "unknown version 81 in decoding a WIF private key"},
+		{"KwdMAjGmerYanjeui5SHS7JkmpZvVipYvB2LJGU1ZxJwYvP98618", base58.ErrChecksum.Error()},
+		{"KwdMAjGmerYanjeui5SHS7JkmpZvVipYvB2LJGU1ZxJwYvP9861l", "invalid character at offset 51 in decoding a base58 string"},
+	}
+	for _, tc := range testCases {
+		_, err := DecodeWIF(tc.wif)
+		if err == nil || err.Error() != tc.expected {
+			t.Errorf("expected: %v\ngot: %v", tc.expected, err)
+		}
+		if err != nil && strings.Contains(err.Error(), tc.wif[1:20]) {
+			t.Errorf("Error message should not contain the input: %v", err)
+		}
+	}
+	_, err := DecodeWIF("KwdMAjGmerYanjeui5SHS7JkmpZvVipYvB2LJGU1ZxJwYvP9861l")
+	if cerr := (*base58.CorruptInputError)(nil); !errors.As(err, &cerr) || cerr.Offset != 51 {
+		t.Errorf("expected CorruptInputError at offset 51 but got: %#v", err)
+	}
+}
@@ -0,0 +1,249 @@
+package base58
+
+import (
+	"fmt"
+	"io"
+	"math/bits"
+	"slices"
+)
+
+// blockSize is the number of bytes in a full block of the block encoding.
+const blockSize = 8
+
+var (
+	// blockLens[n] is the encoded length of a block of n bytes.
+	blockLens = [blockSize + 1]int{0, 2, 3, 5, 6, 7, 9, 10, 11}
+	// blockSizes[n] is the number of bytes of a block of n encoded characters,
+	// or -1 if no block is encoded to the length.
+	blockSizes = [Uint64Width + 1]int{0, -1, 1, 2, -1, 3, 4, 5, -1, 6, 7, 8}
+)
+
+// BlockEncodedLen returns the length of the block encoding of n bytes.
+func BlockEncodedLen(n int) int {
+	return n/blockSize*Uint64Width + blockLens[n%blockSize]
+}
+
+// EncodeBlocks encodes the byte slice in blocks of 8 bytes, as in Monero
+// addresses. Each block is encoded as a big-endian number to 11 characters,
+// and the trailing block of n bytes to the fixed length of the table
+// 0, 2, 3, 5, 6, 7, 9, 10 for n = 0 to 7, padding with the first character of
+// the alphabet. Use BitcoinEncoding for the Monero compatible encoding.
+func (enc *Encoding) EncodeBlocks(src []byte) []byte {
+	return enc.AppendEncodeBlocks(make([]byte, 0, BlockEncodedLen(len(src))), src)
+}
+
+// AppendEncodeBlocks appends the block encoding of src to dst, and returns the
+// extended buffer.
+func (enc *Encoding) AppendEncodeBlocks(dst, src []byte) []byte {
+	dst = slices.Grow(dst, BlockEncodedLen(len(src)))
+	for len(src) > 0 {
+		n := min(len(src), blockSize)
+		dst = enc.appendEncodeBlock(dst, src[:n])
+		src = src[n:]
+	}
+	return dst
+}
+
+func (enc *Encoding) appendEncodeBlock(dst, block []byte) []byte {
+	var n uint64
+	for _, c := range block {
+		n = n<<8 | uint64(c)
+	}
+	// 256^len(block) <= 58^blockLens[len(block)], so this never overflows.
+	dst, _ = enc.AppendEncodeUint64Fixed(dst, n, blockLens[len(block)])
+	return dst
+}
+
+// DecodeBlocks decodes the block encoded bytes. The length of the trailing
+// block must be in the block size table, and each block must fit in the bytes.
+func (enc *Encoding) DecodeBlocks(src []byte) ([]byte, error) {
+	return enc.AppendDecodeBlocks(nil, src)
+}
+
+// AppendDecodeBlocks appends the block decoding of src to dst, and returns
+// the extended buffer. On error, this method returns dst without the decoded
+// blocks.
+func (enc *Encoding) AppendDecodeBlocks(dst, src []byte) ([]byte, error) {
+	for i, c := range src {
+		if enc.decodeMap[c] < 0 {
+			return dst, newCorruptInputError("block base58", src, i)
+		}
+	}
+	n := len(src) % Uint64Width
+	if blockSizes[n] < 0 {
+		return dst, blockLengthError(n, excerpt(src, len(src)))
+	}
+	l := len(dst)
+	dst = slices.Grow(dst, len(src)/Uint64Width*blockSize+blockSizes[n])
+	for i := 0; i < len(src); i += Uint64Width {
+		var ok bool
+		block := src[i:min(i+Uint64Width, len(src))]
+		if dst, ok = enc.appendDecodeBlock(dst, block); !ok {
+			return dst[:l], blockOverflowError(len(block), i, excerpt(src, i))
+		}
+	}
+	return dst, nil
+}
+
+// appendDecodeBlock appends the bytes of the block of valid characters of a
+// valid length, and reports whether the value fits in the bytes.
+func (enc *Encoding) appendDecodeBlock(dst, block []byte) ([]byte, bool) {
+	var n, hi, carry uint64
+	for _, c := range block {
+		hi, n = bits.Mul64(n, radix)
+		n, carry = bits.Add64(n, uint64(enc.decodeMap[c]), 0)
+		if hi > 0 || carry > 0 {
+			return dst, false
+		}
+	}
+	size := blockSizes[len(block)]
+	if size < blockSize && n>>(size*8) > 0 {
+		return dst, false
+	}
+	for i := size - 1; i >= 0; i-- {
+		dst = append(dst, byte(n>>(i*8)))
+	}
+	return dst, true
+}
+
+func blockLengthError(n int, excerpt string) error {
+	return fmt.Errorf("invalid length %d of the last block in decoding a block base58 string %s",
+		n, excerpt)
+}
+
+func blockOverflowError(n, offset int, excerpt string) error {
+	return fmt.Errorf("%w of %d-byte block at offset %d in decoding a block base58 string %s",
+		ErrOverflow, blockSizes[n], offset, excerpt)
+}
+
+// NewBlockEncoder returns a new block encoder, which encodes the written bytes
+// in the same way as EncodeBlocks. Each block is written to w as soon as it is
+// filled, and the trailing block is written on Close.
+func NewBlockEncoder(enc *Encoding, w io.Writer) io.WriteCloser {
+	return &blockEncoder{enc: enc, w: w}
+}
+
+type blockEncoder struct {
+	enc   *Encoding
+	w     io.Writer
+	block [blockSize]byte
+	n     int
+	buf   []byte
+	err   error
+}
+
+func (e *blockEncoder) Write(p []byte) (int, error) {
+	if e.err != nil {
+		return 0, e.err
+	}
+	l := len(p)
+	for len(p) > 0 {
+		e.buf = e.buf[:0]
+		for len(p) > 0 && len(e.buf) < 1024 {
+			k := copy(e.block[e.n:], p)
+			e.n, p = e.n+k, p[k:]
+			if e.n == blockSize {
+				e.buf, e.n = e.enc.appendEncodeBlock(e.buf, e.block[:]), 0
+			}
+		}
+		if len(e.buf) > 0 {
+			if _, e.err = e.w.Write(e.buf); e.err != nil {
+				return l - len(p), e.err
+			}
+		}
+	}
+	return l, nil
+}
+
+// Close flushes the trailing block to the underlying writer.
+func (e *blockEncoder) Close() error {
+	if e.err != nil || e.n == 0 {
+		return e.err
+	}
+	e.buf = e.enc.appendEncodeBlock(e.buf[:0], e.block[:e.n])
+	e.n = 0
+	_, e.err = e.w.Write(e.buf)
+	return e.err
+}
+
+// NewBlockDecoder returns a new block decoder, which decodes in the same way as
+// DecodeBlocks. Newline characters in the input are ignored. Unlike NewDecoder,
+// each block is decoded as soon as it is read, so the decoder runs in linear
+// time and constant space.
+func NewBlockDecoder(enc *Encoding, r io.Reader) io.Reader {
+	return &blockDecoder{enc: enc, r: r}
+}
+
+type blockDecoder struct {
+	enc    *Encoding
+	r      io.Reader
+	block  [Uint64Width]byte
+	n      int
+	start  int // offset of the first character of the block
+	offset int
+	buf    []byte
+	out    []byte
+	err    error
+}
+
+func (d *blockDecoder) Read(p []byte) (int, error) {
+	for len(d.out) == 0 {
+		if d.err != nil {
+			return 0, d.err
+		}
+		d.err = d.consume()
+	}
+	n := copy(p, d.out)
+	d.out = d.out[n:]
+	return n, nil
+}
+
+// consume reads the input once, and decodes the filled blocks to out.
+func (d *blockDecoder) consume() error {
+	if d.buf == nil {
+		d.buf = make([]byte, 1024+1024/Uint64Width*blockSize+blockSize)
+	}
+	in, out := d.buf[:1024], d.buf[1024:1024]
+	n, err := d.r.Read(in)
+	for i, c := range in[:n] {
+		if c == '\n' || c == '\r' {
+			continue
+		}
+		if d.enc.decodeMap[c] < 0 {
+			err := newCorruptInputError("block base58", in[:n], i)
+			err.Offset += d.offset
+			return err
+		}
+		if d.n == 0 {
+			d.start = d.offset + i
+		}
+		d.block[d.n] = c
+		if d.n++; d.n == Uint64Width {
+			var err error
+			if out, err = d.decodeBlock(out); err != nil {
+				return err
+			}
+		}
+	}
+	d.offset += n
+	d.out = out
+	if err == io.EOF && d.n > 0 {
+		if blockSizes[d.n] < 0 {
+			return blockLengthError(d.n, excerpt(d.block[:d.n], d.n))
+		}
+		if d.out, err = d.decodeBlock(d.out); err != nil {
+			return err
+		}
+		return io.EOF
+	}
+	return err
+}
+
+func (d *blockDecoder) decodeBlock(out []byte) ([]byte, error) {
+	out, ok := d.enc.appendDecodeBlock(out, d.block[:d.n])
+	if !ok {
+		return out, blockOverflowError(d.n, d.start, excerpt(d.block[:d.n], 0))
+	}
+	d.n = 0
+	return out, nil
+}
@@ -0,0 +1,194 @@
+package base58
+
+import (
+	"bytes"
+	"encoding/hex"
+	"errors"
+	"io"
+	"math/rand"
+	"strings"
+	"testing"
+	"testing/iotest"
+)
+
+var blockTestCases = []struct {
+	decoded, encoded string
+}{
+	{"", ""},
+	{"00", "11"},
+	{"39", "1z"},
+	{"ff", "5Q"},
+	{"0000", "111"},
+	{"0039", "11z"},
+	{"0100", "15R"},
+	{"ffff", "LUv"},
+	{"000000", "11111"},
+	{"000039", "1111z"},
+	{"010000", "11LUw"},
+	{"ffffff", "2UzHL"},
+	{"00000039", "11111z"},
+	{"ffffffff", "7YXq9G"},
+	{"0000000039", "111111z"},
+	{"ffffffffff", "VtB5VXc"},
+	{"000000000039", "11111111z"},
+	{"ffffffffffff", "3CUsUpv9t"},
+	{"00000000000039", "111111111z"},
+	{"ffffffffffffff", "Ahg1opVcGW"},
+	{"0000000000000039", "1111111111z"},
+	{"ffffffffffffffff", "jpXCZedGfVQ"},
+	{"0000000000000000", "11111111111"},
+	{"000000000000003a", "11111111121"},
+	{"06156013762879f7", "22222222222"},
+	{"05e022ba374b2a00", "1z111111111"},
+	{"06156013762879f7ffffffffff", "22222222222VtB5VXc"},
+	{ // Monero address
+		"1242f18fc61586554095b0799b5c4b6f00cdeb26a93b20540d366932c6001617b75db35109fbba7d5f275fef4b9c49e0cc1c84b219ec6ff652fda54f89f7f63c887ec4a75d",
+		"44AFFq5kSiGBoZ4NMDwYtN18obc8AemS33DBLWs3H7otXft3XjrpDtQGv7SqSsaBYBb98uNbr2VBBEt7f2wfn3RVGQBEP3A",
+	},
+}
+
+func TestEncodeBlocks(t *testing.T) {
+	for _, tc := range blockTestCases {
+		src, _ := hex.DecodeString(tc.decoded)
+		if got := BitcoinEncoding.EncodeBlocks(src); string(got) != tc.encoded {
+			t.Errorf("EncodeBlocks(%s) = %s, want %s", tc.decoded, got, tc.encoded)
+		}
+		if got := BlockEncodedLen(len(src)); got != len(tc.encoded) {
+			t.Errorf("BlockEncodedLen(%d) = %d, want %d", len(src), got, len(tc.encoded))
+		}
+		got, err := BitcoinEncoding.DecodeBlocks([]byte(tc.encoded))
+		if err != nil {
+			t.Fatalf("Error occurred while decoding %s (%s).", tc.encoded, err)
+		}
+		if hex.EncodeToString(got) != tc.decoded {
+			t.Errorf("DecodeBlocks(%s) = %x, want %s", tc.encoded, got, tc.decoded)
+		}
+	}
+}
+
+func TestEncodeBlocks_Random(t *testing.T) {
+	for range 100 {
+		src := make([]byte, rand.Intn(100))
+		for i := range src {
+			src[i] = byte(rand.Intn(256)) >> rand.Intn(8)
+		}
+		encoded := FlickrEncoding.AppendEncodeBlocks([]byte("prefix:"), src)
+		got, err := FlickrEncoding.AppendDecodeBlocks([]byte("prefix:"), encoded[7:])
+		if err != nil {
+			t.Fatalf("Error occurred while decoding %s (%s).", encoded, err)
+		}
+		if !bytes.Equal(got[7:], src) || string(got[:7]) != "prefix:" {
+			t.Errorf("AppendDecodeBlocks(%s) = %x, want %x", encoded, got, src)
+		}
+	}
+}
+
+func TestDecodeBlocks_Error(t *testing.T) {
+	testCases := []struct {
+		src      string
+		expected string
+	}{
+		{"1", `invalid length 1 of the last block in decoding a block base58 string "1"`},
+		{"1111", `invalid length 4 of the last block in decoding a block base58 string "1111"`},
+		{"2222222222211111111", `invalid length 8 of the last block in decoding a block base58 string "2222222222211111111"`},
+		{"5R", `overflow of 1-byte block at offset 0 in decoding a block base58 string "5R"`},
+		{"LUw", `overflow of 2-byte block at offset 0 in decoding a block base58 string "LUw"`},
+		{"2UzHM", `overflow of 3-byte block at offset 0 in decoding a block base58 string "2UzHM"`},
+		{"7YXq9H", `overflow of 4-byte block at offset 0 in decoding a block base58 string "7YXq9H"`},
+		{"VtB5VXd", `overflow of 5-byte block at offset 0 in decoding a block base58 string "VtB5VXd"`},
+		{"3CUsUpv9u", `overflow of 6-byte block at offset 0 in decoding a block base58 string "3CUsUpv9u"`},
+		{"Ahg1opVcGX", `overflow of 7-byte block at offset 0 in decoding a block base58 string "Ahg1opVcGX"`},
+		{"11111111111jpXCZedGfVR", `overflow of 8-byte block at offset 11 in decoding a block base58 string "11111111111jpXCZedGfVR"`},
+		{"zzzzzzzzzzz", `overflow of 8-byte block at offset 0 in decoding a block base58 string "zzzzzzzzzzz"`},
+		{"111110", `invalid character '0' at offset 5 in decoding a block base58 string "111110"`},
+	}
+	for _, tc := range testCases {
+		_, err := BitcoinEncoding.DecodeBlocks([]byte(tc.src))
+		if err == nil || err.Error() != tc.expected {
+			t.Errorf("expected: %v\ngot: %v", tc.expected, err)
+		}
+		got, _ := BitcoinEncoding.AppendDecodeBlocks([]byte("prefix:"), []byte(tc.src))
+		if string(got) != "prefix:" {
+			t.Errorf("AppendDecodeBlocks should not extend the buffer on error but got %q.", got)
+		}
+		r := NewBlockDecoder(BitcoinEncoding, strings.NewReader(tc.src))
+		if _, err := io.ReadAll(r); err == nil {
+			t.Errorf("Error should occur while decoding %s.", tc.src)
+		}
+	}
+	_, err := BitcoinEncoding.DecodeBlocks([]byte("5R"))
+	if !errors.Is(err, ErrOverflow) {
+		t.Errorf("expected: %v\ngot: %v", ErrOverflow, err)
+	}
+}
+
+func TestBlockEncoder(t *testing.T) {
+	for _, tc := range blockTestCases {
+		src, _ := hex.DecodeString(tc.decoded)
+		var sb strings.Builder
+		w := NewBlockEncoder(BitcoinEncoding, &sb)
+		for i := range src {
+			if _, err := w.Write(src[i : i+1]); err != nil {
+				t.Fatalf("Error occurred while encoding %s (%s).", tc.decoded, err)
+			}
+		}
+		if err := w.Close(); err != nil {
+			t.Fatalf("Error occurred while encoding %s (%s).", tc.decoded, err)
+		}
+		if got := sb.String(); got != tc.encoded {
+			t.Errorf("NewBlockEncoder(%s) = %s, want %s", tc.decoded, got, tc.encoded)
+		}
+	}
+}
+
+func TestBlockDecoder(t *testing.T) {
+	for _, tc := range blockTestCases {
+		r := NewBlockDecoder(BitcoinEncoding, iotest.OneByteReader(strings.NewReader(tc.encoded)))
+		got, err := io.ReadAll(iotest.OneByteReader(r))
+		if err != nil {
+			t.Fatalf("Error occurred while decoding %s (%s).", tc.encoded, err)
+		}
+		if hex.EncodeToString(got) != tc.decoded {
+			t.Errorf("NewBlockDecoder(%s) = %x, want %s", tc.encoded, got, tc.decoded)
+		}
+	}
+}
+
+func TestBlockEncoder_Large(t *testing.T) {
+	src := make([]byte, 100000)
+	for i := range src {
+		src[i] = byte(rand.Intn(256))
+	}
+	var buf bytes.Buffer
+	w := NewBlockEncoder(RippleEncoding, &buf)
+	if _, err := w.Write(src); err != nil {
+		t.Fatalf("Error occurred while encoding (%s).", err)
+	}
+	if err := w.Close(); err != nil {
+		t.Fatalf("Error occurred while encoding (%s).", err)
+	}
+	encoded := buf.Bytes()
+	if expected := RippleEncoding.EncodeBlocks(src); !bytes.Equal(encoded, expected) {
+		t.Fatalf("NewBlockEncoder should encode in the same way as EncodeBlocks")
+	}
+	lines := bytes.Join(chunk(encoded, 76), []byte("\n"))
+	got, err := io.ReadAll(NewBlockDecoder(RippleEncoding, bytes.NewReader(lines)))
+	if err != nil {
+		t.Fatalf("Error occurred while decoding (%s).", err)
+	}
+	if !bytes.Equal(got, src) {
+		t.Errorf("NewBlockDecoder should decode the encoded bytes")
+	}
+	_, err = io.ReadAll(NewBlockDecoder(RippleEncoding, bytes.NewReader(append(lines, '0'))))
+	if expected := "invalid character '0' at offset"; err == nil || !strings.Contains(err.Error(), expected) {
+		t.Errorf("expected: %v\ngot: %v", expected, err)
+	}
+}
+
+func chunk(src []byte, n int) [][]byte {
+	var xs [][]byte
+	for len(src) > n {
+		xs, src = append(xs, src[:n]), src[n:]
+	}
+	return append(xs, src)
+}
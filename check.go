@@ -0,0 +1,73 @@
+package base58
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"slices"
+)
+
+// ErrChecksum is returned when the checksum of a base58check string mismatches.
+var ErrChecksum = errors.New("checksum mismatch in decoding a base58check string")
+
+// ErrInvalidLength is returned when a base58check string is too short to
+// contain the version prefix and the checksum.
+var ErrInvalidLength = errors.New("invalid length in decoding a base58check string")
+
+// A VersionError is returned when the version prefix of a base58check string
+// differs from the expected one.
+type VersionError struct {
+	Expected, Got []byte
+}
+
+func (err *VersionError) Error() string {
+	return fmt.Sprintf("unexpected version %x in decoding a base58check string (expected %x)",
+		err.Got, err.Expected)
+}
+
+const checksumLen = 4
+
+func checksum(src []byte) [checksumLen]byte {
+	h := sha256.Sum256(src)
+	h = sha256.Sum256(h[:])
+	return [checksumLen]byte(h[:checksumLen])
+}
+
+// CheckEncode encodes the payload prefixed with the version bytes, followed
+// by the first four bytes of the double SHA-256 hash of them (base58check).
+func (enc *Encoding) CheckEncode(version, payload []byte) []byte {
+	buf := slices.Concat(version, payload)
+	sum := checksum(buf)
+	return enc.EncodeBytes(append(buf, sum[:]...))
+}
+
+// CheckDecode decodes the base58check string, verifies the checksum, and
+// returns the version bytes of the specified length and the payload.
+func (enc *Encoding) CheckDecode(src []byte, versionLen int) (version, payload []byte, err error) {
+	buf, err := enc.DecodeBytes(src)
+	if err != nil {
+		return nil, nil, err
+	}
+	if len(buf) < versionLen+checksumLen {
+		return nil, nil, ErrInvalidLength
+	}
+	buf, sum := buf[:len(buf)-checksumLen], buf[len(buf)-checksumLen:]
+	if expected := checksum(buf); !bytes.Equal(sum, expected[:]) {
+		return nil, nil, ErrChecksum
+	}
+	return buf[:versionLen], buf[versionLen:], nil
+}
+
+// CheckDecodeVersion decodes the base58check string, verifies the checksum and
+// the version bytes, and returns the payload.
+func (enc *Encoding) CheckDecodeVersion(src, version []byte) ([]byte, error) {
+	got, payload, err := enc.CheckDecode(src, len(version))
+	if err != nil {
+		return nil, err
+	}
+	if !bytes.Equal(got, version) {
+		return nil, &VersionError{Expected: version, Got: got}
+	}
+	return payload, nil
+}
@@ -0,0 +1,76 @@
+package base58
+
+import (
+	"encoding/hex"
+	"errors"
+	"testing"
+)
+
+var checkTestcases = []struct {
+	encoding *Encoding
+	version  string
+	payload  string
+	encoded  string
+}{
+	{BitcoinEncoding, "00", "eb15231dfceb60925886b67d065299925915aeb1", "1NS17iag9jJgTHD1VXjvLCEnZuQ3rJED9L"},
+	{BitcoinEncoding, "05", "0000000000000000000000000000000000000000", "31h1vYVSYuKP6AhS86fbRdMw9XHieotbST"},
+	{BitcoinEncoding, "06a19f", "0000000000000000000000000000000000000000", "tz1Ke2h7sDdakHJQh8WX4Z372du1KChsksyU"},
+	{BitcoinEncoding, "06a19f", "2e3c9ba04c2b3ad43a8a3ba1e79d97ed0ff9e9fd", "tz1PrWP49iPtYv8iZVEPPh4eK3zjURD4Ejth"},
+	{BitcoinEncoding, "", "", "3QJmnh"},
+	{BitcoinEncoding, "00", "", "1Wh4bh"},
+	{RippleEncoding, "00", "b5f762798a53d543a014caf8b297cff8f2f937e8", "rHb9CJAWyB4rj91VRWn96DkukG4bwdtyTh"},
+}
+
+func TestCheckEncode(t *testing.T) {
+	for _, tc := range checkTestcases {
+		version, _ := hex.DecodeString(tc.version)
+		payload, _ := hex.DecodeString(tc.payload)
+		got := tc.encoding.CheckEncode(version, payload)
+		if string(got) != tc.encoded {
+			t.Errorf("CheckEncode(%s, %s) = %s, want %s", tc.version, tc.payload, got, tc.encoded)
+		}
+	}
+}
+
+func TestCheckDecode(t *testing.T) {
+	for _, tc := range checkTestcases {
+		version, payload, err := tc.encoding.CheckDecode([]byte(tc.encoded), len(tc.version)/2)
+		if err != nil {
+			t.Fatalf("Error occurred while decoding %s (%s).", tc.encoded, err)
+		}
+		if hex.EncodeToString(version) != tc.version || hex.EncodeToString(payload) != tc.payload {
+			t.Errorf("CheckDecode(%s) = %x, %x, want %s, %s", tc.encoded, version, payload, tc.version, tc.payload)
+		}
+		expected, _ := hex.DecodeString(tc.version)
+		payload, err = tc.encoding.CheckDecodeVersion([]byte(tc.encoded), expected)
+		if err != nil {
+			t.Fatalf("Error occurred while decoding %s (%s).", tc.encoded, err)
+		}
+		if hex.EncodeToString(payload) != tc.payload {
+			t.Errorf("CheckDecodeVersion(%s) = %x, want %s", tc.encoded, payload, tc.payload)
+		}
+	}
+}
+
+func TestCheckDecode_Error(t *testing.T) {
+	_, _, err := BitcoinEncoding.CheckDecode([]byte("1NS17iag9jJgTHD1VXjvLCEnZuQ3rJED9M"), 1)
+	if !errors.Is(err, ErrChecksum) {
+		t.Errorf("expected: %v\ngot: %v", ErrChecksum, err)
+	}
+	_, _, err = BitcoinEncoding.CheckDecode([]byte("3QJmnh"), 1)
+	if !errors.Is(err, ErrInvalidLength) {
+		t.Errorf("expected: %v\ngot: %v", ErrInvalidLength, err)
+	}
+	_, err = BitcoinEncoding.CheckDecodeVersion([]byte("31h1vYVSYuKP6AhS86fbRdMw9XHieotbST"), []byte{0x00})
+	var verr *VersionError
+	if !errors.As(err, &verr) {
+		t.Fatalf("expected VersionError but got: %v", err)
+	}
+	if expected := "unexpected version 05 in decoding a base58check string (expected 00)"; err.Error() != expected {
+		t.Errorf("expected: %v\ngot: %v", expected, err)
+	}
+	_, err = RippleEncoding.CheckDecodeVersion([]byte("1NS17iag9jJgTHD1VXjvLCEnZuQ3rJED9L"), []byte{0x00})
+	if err == nil {
+		t.Errorf("Error should occur while decoding a bitcoin address with ripple encoding.")
+	}
+}
@@ -14,9 +14,12 @@ func parseFlags(args []string, opts any) ([]string, error) {
 	longToValue := map[string]reflect.Value{}
 	shortToValue := map[string]reflect.Value{}
 	valueToChoices := map[reflect.Value][]string{}
+	valueToLong := map[reflect.Value]string{}
+	setFlags := map[string]bool{}
 	for i, l := 0, val.NumField(); i < l; i++ {
 		if flag, ok := typ.Field(i).Tag.Lookup("long"); ok {
 			longToValue[flag] = val.Field(i)
+			valueToLong[val.Field(i)] = flag
 		}
 		if flag, ok := typ.Field(i).Tag.Lookup("short"); ok {
 			shortToValue[flag] = val.Field(i)
@@ -94,6 +97,7 @@ func parseFlags(args []string, opts any) ([]string, error) {
 			continue
 		}
 	S:
+		setFlags[valueToLong[val]] = true
 		switch val.Kind() {
 		case reflect.Bool:
 			val.SetBool(true)
@@ -148,6 +152,13 @@ func parseFlags(args []string, opts any) ([]string, error) {
 			goto S
 		}
 	}
+	for i, l := 0, typ.NumField(); i < l; i++ {
+		if flag, ok := typ.Field(i).Tag.Lookup("conflicts"); ok {
+			if long := typ.Field(i).Tag.Get("long"); setFlags[long] && setFlags[flag] {
+				return nil, fmt.Errorf("flag `--%s' cannot be used with `--%s'", long, flag)
+			}
+		}
+	}
 	return rest, nil
 }
 
@@ -191,8 +202,12 @@ func formatFlags(opts any) string {
 			sb.WriteString("[")
 			sb.WriteString(strings.ReplaceAll(choices, ",", "|"))
 			sb.WriteString("] ")
+		} else if choices, ok := flagChoices[tag.Get("long")]; ok {
+			sb.WriteString("[")
+			sb.WriteString(strings.Join(choices(), "|"))
+			sb.WriteString("] ")
 		}
-		sb.WriteString(strings.Repeat(" ", 37-sb.Len()+m))
+		sb.WriteString(strings.Repeat(" ", max(37-sb.Len()+m, 0)))
 		sb.WriteString(tag.Get("description"))
 		if def, ok := typ.Field(i).Tag.Lookup("default"); ok {
 			sb.WriteString(" (default: ")
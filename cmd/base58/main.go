@@ -5,9 +5,11 @@ import (
 	"bytes"
 	"fmt"
 	"io"
+	"math"
 	"os"
 	"runtime"
 	"slices"
+	"strconv"
 	"unicode"
 	"unicode/utf8"
 
@@ -41,14 +43,43 @@ type cli struct {
 
 type flagopts struct {
 	Decode   bool             `short:"D" long:"decode" description:"decode input"`
-	Encoding *base58.Encoding `short:"e" long:"encoding" default:"flickr" choices:"flickr,ripple,bitcoin" description:"encoding name"`
+	Encoding *base58.Encoding `short:"e" long:"encoding" default:"flickr" description:"encoding name"`
+	Alphabet *alphabet        `long:"alphabet" conflicts:"encoding" description:"custom alphabet"`
+	To       *base58.Encoding `long:"to" conflicts:"decode" description:"transcode to encoding"`
 	Input    []string         `short:"i" long:"input" default:"-" description:"input file"`
 	Output   string           `short:"o" long:"output" default:"-" description:"output file"`
 	Version  bool             `short:"v" long:"version" description:"print version"`
 	Help     bool             `short:"h" long:"help" description:"print help"`
 }
 
+type prefixopts struct {
+	Encoding *base58.Encoding `short:"e" long:"encoding" default:"flickr" description:"encoding name"`
+	Alphabet *alphabet        `long:"alphabet" conflicts:"encoding" description:"custom alphabet"`
+	Check    bool             `short:"c" long:"check" description:"include the 4-byte base58check checksum in LENGTH"`
+	Help     bool             `short:"h" long:"help" description:"print help"`
+}
+
+// alphabet is a custom alphabet, which is validated on parsing the flag.
+type alphabet struct {
+	encoding *base58.Encoding
+}
+
+// UnmarshalFlag implements flags.Unmarshaler
+func (a *alphabet) UnmarshalFlag(value string) (err error) {
+	a.encoding, err = base58.NewEncoding([]byte(value))
+	return err
+}
+
+// flagChoices provides the choices of the flags from the encoding registry.
+var flagChoices = map[string]func() []string{
+	"encoding": base58.Names,
+	"to":       base58.Names,
+}
+
 func (cli *cli) run(args []string) int {
+	if len(args) > 0 && args[0] == "prefix" {
+		return cli.runPrefix(args[1:])
+	}
 	var opts flagopts
 	args, err := parseFlags(args, &opts)
 	if err != nil {
@@ -56,7 +87,8 @@ func (cli *cli) run(args []string) int {
 		return exitCodeErr
 	}
 	if opts.Help {
-		fmt.Fprintf(cli.outStream, "Usage:\n  %s [OPTIONS]\n\n%s", name, formatFlags(&opts))
+		fmt.Fprintf(cli.outStream, "Usage:\n  %s [OPTIONS]\n  %s prefix [OPTIONS] TAG LENGTH\n\n%s",
+			name, name, formatFlags(&opts))
 		return exitCodeOK
 	}
 	if opts.Version {
@@ -72,8 +104,15 @@ func (cli *cli) run(args []string) int {
 		defer file.Close()
 		cli.outStream = file
 	}
+	if opts.Alphabet != nil {
+		opts.Encoding = opts.Alphabet.encoding
+	}
 	var f func([]byte) ([]byte, error)
-	if opts.Decode {
+	if opts.To != nil {
+		f = func(src []byte) ([]byte, error) {
+			return base58.Transcode(opts.To, opts.Encoding, src)
+		}
+	} else if opts.Decode {
 		f = opts.Encoding.Decode
 	} else {
 		f = opts.Encoding.Encode
@@ -88,6 +127,43 @@ func (cli *cli) run(args []string) int {
 	return status
 }
 
+// runPrefix prints the shortest version bytes in hex such that the encodings
+// of all payloads of the length start with the tag.
+func (cli *cli) runPrefix(args []string) int {
+	var opts prefixopts
+	args, err := parseFlags(args, &opts)
+	if err != nil {
+		fmt.Fprintf(cli.errStream, "%s: %s\n", name, err)
+		return exitCodeErr
+	}
+	if opts.Help {
+		fmt.Fprintf(cli.outStream, "Usage:\n  %s prefix [OPTIONS] TAG LENGTH\n\n%s", name, formatFlags(&opts))
+		return exitCodeOK
+	}
+	if len(args) != 2 {
+		fmt.Fprintf(cli.errStream, "%s: expected TAG and LENGTH but got %d arguments\n", name, len(args))
+		return exitCodeErr
+	}
+	n, err := strconv.Atoi(args[1])
+	if err != nil {
+		fmt.Fprintf(cli.errStream, "%s: invalid payload length: %s\n", name, args[1])
+		return exitCodeErr
+	}
+	if opts.Alphabet != nil {
+		opts.Encoding = opts.Alphabet.encoding
+	}
+	if opts.Check {
+		n += 4
+	}
+	prefix, err := opts.Encoding.VersionPrefix(args[0], n)
+	if err != nil {
+		fmt.Fprintf(cli.errStream, "%s: %s\n", name, err)
+		return exitCodeErr
+	}
+	fmt.Fprintf(cli.outStream, "%x\n", prefix)
+	return exitCodeOK
+}
+
 func (cli *cli) runInternal(fname string, f func([]byte) ([]byte, error)) int {
 	var in io.Reader
 	if fname == "-" {
@@ -102,6 +178,7 @@ func (cli *cli) runInternal(fname string, f func([]byte) ([]byte, error)) int {
 		in = file
 	}
 	scanner := bufio.NewScanner(in)
+	scanner.Buffer(make([]byte, 4096), math.MaxInt) // allow very large numbers
 	status := exitCodeOK
 	for scanner.Scan() {
 		result, err := processLine(scanner.Bytes(), f)
@@ -113,6 +190,10 @@ func (cli *cli) runInternal(fname string, f func([]byte) ([]byte, error)) int {
 		cli.outStream.Write(result)
 		cli.outStream.Write([]byte{'\n'})
 	}
+	if err := scanner.Err(); err != nil {
+		fmt.Fprintf(cli.errStream, "%s: %s\n", name, err)
+		return exitCodeErr
+	}
 	return status
 }
 
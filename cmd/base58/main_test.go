@@ -134,8 +134,8 @@ jpXCZedGfVR
 			input: `foo
 bar
 `,
-			err: `expecting a non-negative number but got "foo"
-expecting a non-negative number but got "bar"
+			err: `invalid character 'f' at offset 0 in encoding a base10 number "foo"
+invalid character 'b' at offset 0 in encoding a base10 number "bar"
 `,
 		},
 		{
@@ -272,6 +272,50 @@ jpXCZedGfVR
 			expected: `
 0 32 64		  		000	512
    1024 16777216
+`,
+		},
+		{
+			name: "encode bitcoin alias",
+			args: []string{"-e", "btc"},
+			input: `
+32
+79228162514264337593543950336
+`,
+			expected: `
+Z
+5qCHTcgbQwpvYZQ9d
+`,
+		},
+		{
+			name: "encode alphabet",
+			args: []string{"--alphabet", "zyxwvutsrqponmkjihgfedcbaZYXWVUTSRQPNMLKJHGFEDCBA987654321"},
+			input: `
+0
+32
+000
+18446744073709551616
+`,
+			expected: `
+z
+S
+zzz
+GBUoSMNjLWa
+`,
+		},
+		{
+			name: "decode alphabet",
+			args: []string{"-D", "--alphabet=zyxwvutsrqponmkjihgfedcbaZYXWVUTSRQPNMLKJHGFEDCBA987654321"},
+			input: `
+z
+S
+zzz
+GBUoSMNjLWa
+`,
+			expected: `
+0
+32
+000
+18446744073709551616
 `,
 		},
 		{
@@ -286,8 +330,8 @@ jpXCZedGfVR
 			input: `FOO
 Fal
 `,
-			err: `invalid character 'O' in decoding a base58 string "FOO"
-invalid character 'l' in decoding a base58 string "Fal"
+			err: `invalid character 'O' at offset 1 in decoding a base58 string "FOO"
+invalid character 'l' at offset 2 in decoding a base58 string "Fal"
 `,
 		},
 		{
@@ -306,10 +350,33 @@ invalid character 'l' in decoding a base58 string "Fal"
 			args: []string{"--encoding"},
 			err:  name + ": expected argument for flag `--encoding'\n",
 		},
+		{
+			name: "alphabet error",
+			args: []string{"--alphabet", "123"},
+			err: name + ": invalid argument for flag `--alphabet': " +
+				"invalid base58 alphabet length: expected 58 but got 3\n",
+		},
+		{
+			name: "alphabet error empty",
+			args: []string{"--alphabet="},
+			err: name + ": invalid argument for flag `--alphabet': " +
+				"invalid base58 alphabet length: expected 58 but got 0\n",
+		},
+		{
+			name: "alphabet error duplicate",
+			args: []string{"--alphabet", "zyxwvutsrqponmkjihgfedcbaZYXWVUTSRQPNMLKJHGFEDCBA98765432z"},
+			err: name + ": invalid argument for flag `--alphabet': " +
+				"duplicate character 'z' at offsets 0 and 57 in base58 alphabet\n",
+		},
+		{
+			name: "alphabet and encoding error",
+			args: []string{"-e", "bitcoin", "--alphabet", "zyxwvutsrqponmkjihgfedcbaZYXWVUTSRQPNMLKJHGFEDCBA987654321"},
+			err:  name + ": flag `--alphabet' cannot be used with `--encoding'\n",
+		},
 		{
 			name:  "negative number error",
 			input: "-100000000000000000000",
-			err: `expecting a non-negative number but got "-100000000000000000000"
+			err: `invalid character '-' at offset 0 in encoding a base10 number "-100000000000000000000"
 `,
 		},
 		{
@@ -329,6 +396,17 @@ invalid character 'l' in decoding a base58 string "Fal"
 			errRe: regexp.MustCompile(name + ": open --input: (?:no such file or directory|" +
 				"The system cannot find the file specified\\.)\n"),
 		},
+		{
+			name:     "encode long line",
+			input:    strings.Repeat("0", 100000) + " 0\n",
+			expected: strings.Repeat("1", 100000) + " 1\n",
+		},
+		{
+			name:     "decode long line",
+			args:     []string{"-D"},
+			input:    strings.Repeat("1", 100000) + " 1\n",
+			expected: strings.Repeat("0", 100000) + " 0\n",
+		},
 		{
 			name: "invalid flag",
 			args: []string{"--foo"},
@@ -342,7 +420,101 @@ invalid character 'l' in decoding a base58 string "Fal"
 		{
 			name:       "help flag",
 			args:       []string{"--help"},
-			expectedRe: regexp.MustCompile("-h, --help"),
+			expectedRe: regexp.MustCompile(`(?s)--encoding=\[flickr\|ripple\|bitcoin\].*-h, --help`),
+		},
+		{
+			name: "transcode",
+			args: []string{"--to", "bitcoin"},
+			input: `
+1
+y
+9Q
+1112NGvhhq
+JPwcyDCgEuq
+`,
+			expected: `
+1
+Z
+9q
+1112ohWHHR
+jpXCZedGfVR
+`,
+		},
+		{
+			name:     "transcode encoding",
+			args:     []string{"-e", "ripple", "--to=flickr"},
+			input:    "rrrpo6WHHR jFXUZedGCVR\n",
+			expected: "1112NGvhhq JPwcyDCgEuq\n",
+		},
+		{
+			name:     "transcode alphabet",
+			args:     []string{"--alphabet", "zyxwvutsrqponmkjihgfedcbaZYXWVUTSRQPNMLKJHGFEDCBA987654321", "--to=bitcoin"},
+			input:    "rAUV4\n",
+			expected: "9qXWw\n",
+		},
+		{
+			name:  "transcode error",
+			args:  []string{"--to", "bitcoin"},
+			input: "1O\n",
+			err:   "invalid character 'O' at offset 1 in decoding a base58 string \"1O\"\n",
+		},
+		{
+			name: "transcode decode error",
+			args: []string{"-D", "--to", "bitcoin"},
+			err:  name + ": flag `--to' cannot be used with `--decode'\n",
+		},
+		{
+			name: "transcode encoding error",
+			args: []string{"--to", "foo"},
+			err: name + ": invalid argument for flag `--to': " +
+				"expected one of [flickr, ripple, bitcoin] but got foo\n",
+		},
+		{
+			name:     "prefix",
+			args:     []string{"prefix", "-e", "bitcoin", "--check", "tz1", "20"},
+			expected: "06a19f\n",
+		},
+		{
+			name:     "prefix without checksum",
+			args:     []string{"prefix", "-e", "bitcoin", "r", "24"},
+			expected: "7a\n",
+		},
+		{
+			name:     "prefix alphabet",
+			args:     []string{"prefix", "--alphabet", "rpshnaf39wBUDNEGHJKLM4PQRST7VWXYZ2bcdeCg65jkm8oFqi1tuvAxyz", "-c", "r", "20"},
+			expected: "00\n",
+		},
+		{
+			name: "prefix alphabet error",
+			args: []string{"prefix", "--alphabet", "", "tz1", "20"},
+			err: name + ": invalid argument for flag `--alphabet': " +
+				"invalid base58 alphabet length: expected 58 but got 0\n",
+		},
+		{
+			name: "prefix arguments error",
+			args: []string{"prefix", "tz1"},
+			err:  name + ": expected TAG and LENGTH but got 1 arguments\n",
+		},
+		{
+			name: "prefix length error",
+			args: []string{"prefix", "tz1", "x"},
+			err:  name + ": invalid payload length: x\n",
+		},
+		{
+			name: "prefix tag error",
+			args: []string{"prefix", "-e", "bitcoin", "tzl", "20"},
+			err:  name + ": invalid character 'l' at offset 2 in decoding a base58 string \"tzl\"\n",
+		},
+		{
+			name: "prefix file",
+			args: []string{"--", "prefix"},
+			errRe: regexp.MustCompile(name + ": open prefix: (?:no such file or directory|" +
+				"The system cannot find the file specified\\.)\n"),
+		},
+		{
+			name:       "prefix help flag",
+			args:       []string{"prefix", "--help"},
+			expectedRe: regexp.MustCompile(`(?s)^Usage:\n  base58 prefix .*-c, --check .*-h, --help`),
 		},
 	}
 	for _, tc := range testCases {
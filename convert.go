@@ -0,0 +1,170 @@
+package base58
+
+import (
+	"math"
+	"math/big"
+	"math/bits"
+	"slices"
+	"sync"
+)
+
+// bigThreshold is the number of digits above which the numbers are converted
+// by the divide-and-conquer algorithm. This is a variable for benchmarks.
+var bigThreshold = 400
+
+// A bigConverter converts between digits in a radix and big.Int by recursive
+// splitting with precomputed powers of the radix, which runs in subquadratic
+// time with the Karatsuba multiplication and the recursive division of big.Int.
+type bigConverter struct {
+	radix    uint
+	wordSize int  // number of digits in a big.Word
+	wordBase uint // radix^wordSize
+	leafSize int  // number of digits converted without splitting
+
+	mu   sync.Mutex
+	pows []*big.Int // pows[i] = radix^(leafSize<<i)
+}
+
+var (
+	base58Converter = newBigConverter(uint(radix))
+	base10Converter = newBigConverter(10)
+)
+
+func newBigConverter(radix uint) *bigConverter {
+	c := &bigConverter{radix: radix, wordBase: 1}
+	for c.wordBase <= math.MaxUint/radix {
+		c.wordBase *= radix
+		c.wordSize++
+	}
+	c.leafSize = c.wordSize * 16
+	return c
+}
+
+// maxCachedPowers is the number of the powers kept in the cache. The larger
+// powers are computed on each conversion, so that a huge input does not keep
+// the powers of its size in memory.
+const maxCachedPowers = 8
+
+// powers returns the powers of the radix such that leafSize<<len(pows) >= n.
+func (c *bigConverter) powers(n int) []*big.Int {
+	l := 1
+	for c.leafSize<<l < n {
+		l++
+	}
+	c.mu.Lock()
+	for len(c.pows) < min(l, maxCachedPowers) {
+		c.pows = append(c.pows, c.nextPower(c.pows))
+	}
+	pows := slices.Clip(c.pows[:min(l, maxCachedPowers)])
+	c.mu.Unlock()
+	for len(pows) < l {
+		pows = append(pows, c.nextPower(pows))
+	}
+	return pows
+}
+
+// nextPower returns radix^(leafSize<<len(pows)).
+func (c *bigConverter) nextPower(pows []*big.Int) *big.Int {
+	if len(pows) == 0 {
+		return new(big.Int).Exp(
+			new(big.Int).SetUint64(uint64(c.radix)), big.NewInt(int64(c.leafSize)), nil)
+	}
+	x := pows[len(pows)-1]
+	return new(big.Int).Mul(x, x)
+}
+
+// parse converts the digits to a big integer. The digits must be valid in the
+// decode map.
+func (c *bigConverter) parse(src []byte, decodeMap *[256]int64) *big.Int {
+	pows := c.powers(len(src))
+	return c.parseLevel(src, decodeMap, pows, len(pows)-1)
+}
+
+func (c *bigConverter) parseLevel(
+	src []byte, decodeMap *[256]int64, pows []*big.Int, level int,
+) *big.Int {
+	for level >= 0 && len(src) <= c.leafSize<<level {
+		level--
+	}
+	if level < 0 {
+		return c.parseWords(src, decodeMap)
+	}
+	m := len(src) - c.leafSize<<level
+	x := c.parseLevel(src[:m], decodeMap, pows, level-1)
+	x.Mul(x, pows[level])
+	return x.Add(x, c.parseLevel(src[m:], decodeMap, pows, level-1))
+}
+
+func (c *bigConverter) parseWords(src []byte, decodeMap *[256]int64) *big.Int {
+	xs := make([]big.Word, 0, len(src)/c.wordSize+1)
+	for k := 0; k < len(src); k += c.wordSize {
+		var n, base uint = 0, 1
+		for _, ch := range src[k:min(k+c.wordSize, len(src))] {
+			n, base = n*c.radix+uint(decodeMap[ch]), base*c.radix
+		}
+		carry := n
+		for j, x := range xs {
+			hi, lo := bits.Mul(uint(x), base)
+			lo, cc := bits.Add(lo, carry, 0)
+			xs[j], carry = big.Word(lo), hi+cc
+		}
+		if carry > 0 {
+			xs = append(xs, big.Word(carry))
+		}
+	}
+	return new(big.Int).SetBits(xs)
+}
+
+// format converts the absolute value of the big integer to digits. The result
+// is padded with the zero digit to the length of a multiple of leafSize.
+func (c *bigConverter) format(x *big.Int, alphabet []byte) []byte {
+	// log_radix(2) < 1/floor(log2(radix))
+	pows := c.powers(x.BitLen()/(bits.Len(c.radix)-1) + 1)
+	buf := make([]byte, c.leafSize<<len(pows))
+	c.fill(buf, x, alphabet, pows, len(pows)-1)
+	return buf
+}
+
+// fill writes the digits of x < radix^len(buf) to buf with padding, where
+// len(buf) = leafSize<<(level+1).
+func (c *bigConverter) fill(buf []byte, x *big.Int, alphabet []byte, pows []*big.Int, level int) {
+	if level < 0 || x.Sign() == 0 {
+		c.fillWords(buf, x, alphabet)
+		return
+	}
+	q, r := new(big.Int).QuoRem(x, pows[level], new(big.Int))
+	m := len(buf) / 2
+	c.fill(buf[:m], q, alphabet, pows, level-1)
+	c.fill(buf[m:], r, alphabet, pows, level-1)
+}
+
+func (c *bigConverter) fillWords(buf []byte, x *big.Int, alphabet []byte) {
+	ws := x.Bits()
+	xs := make([]uint, len(ws))
+	for i, w := range ws {
+		xs[i] = uint(w)
+	}
+	for i := len(buf); i > 0; {
+		var rem uint
+		for j := len(xs) - 1; j >= 0; j-- {
+			xs[j], rem = bits.Div(rem, xs[j], c.wordBase)
+		}
+		for len(xs) > 0 && xs[len(xs)-1] == 0 {
+			xs = xs[:len(xs)-1]
+		}
+		for k := 0; k < c.wordSize && i > 0; k++ {
+			i--
+			buf[i] = alphabet[rem%c.radix]
+			rem /= c.radix
+		}
+	}
+}
+
+// trimLeadingZeros trims the leading zero digits, but leaves the last digit.
+func trimLeadingZeros(buf []byte, zero byte) []byte {
+	var i int
+	for i < len(buf)-1 && buf[i] == zero {
+		i++
+	}
+	return buf[i:]
+}
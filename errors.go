@@ -0,0 +1,97 @@
+package base58
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+)
+
+// ErrOverflow is returned when the decoded number overflows the integer type.
+var ErrOverflow = errors.New("overflow")
+
+// A CorruptInputError is returned when the input has an invalid character in
+// decoding. The error message contains a truncated excerpt of the input.
+type CorruptInputError struct {
+	Char    byte // invalid character
+	Offset  int  // offset of the invalid character
+	name    string
+	excerpt string
+}
+
+func newCorruptInputError(name string, src []byte, offset int) *CorruptInputError {
+	return &CorruptInputError{src[offset], offset, name, excerpt(src, offset)}
+}
+
+func (err *CorruptInputError) Error() string {
+	if err.excerpt == "" {
+		return fmt.Sprintf("invalid character at offset %d in decoding a %s string",
+			err.Offset, err.name)
+	}
+	return fmt.Sprintf("invalid character %q at offset %d in decoding a %s string %s",
+		err.Char, err.Offset, err.name, err.excerpt)
+}
+
+// Redact returns the error without the character and the excerpt of the input,
+// which is suitable to report errors in decoding secrets. The Char field of the
+// returned error is zero.
+func (err *CorruptInputError) Redact() *CorruptInputError {
+	return &CorruptInputError{Offset: err.Offset, name: err.name}
+}
+
+// A LengthError is returned when the decoded bytes do not have the expected
+// length. The error message does not contain the input, which may be a secret.
+type LengthError struct {
+	Length   int // length of the decoded bytes
+	Expected int // expected length
+}
+
+func (err *LengthError) Error() string {
+	return fmt.Sprintf("invalid length %d in decoding a base58 string (expected %d)",
+		err.Length, err.Expected)
+}
+
+// An InvalidDigitError is returned when the input has a non-digit character in
+// encoding a number represented in base 10.
+type InvalidDigitError struct {
+	Char    byte // invalid character
+	Offset  int  // offset of the invalid character
+	excerpt string
+}
+
+// newInvalidDigitError creates an InvalidDigitError for the first non-digit
+// character in the input from the start offset.
+func newInvalidDigitError(src []byte, start int) *InvalidDigitError {
+	i := start
+	for i < len(src)-1 && '0' <= src[i] && src[i] <= '9' {
+		i++
+	}
+	return &InvalidDigitError{src[i], i, excerpt(src, i)}
+}
+
+func (err *InvalidDigitError) Error() string {
+	return fmt.Sprintf("invalid character %q at offset %d in encoding a base10 number %s",
+		err.Char, err.Offset, err.excerpt)
+}
+
+func overflowError(name string, src []byte, typ string) error {
+	return fmt.Errorf("%w of %s in decoding a %s string %s",
+		ErrOverflow, typ, name, excerpt(src, len(src)))
+}
+
+const excerptLen = 32
+
+// excerpt returns the quoted input truncated around the offset.
+func excerpt(src []byte, offset int) string {
+	if len(src) <= excerptLen {
+		return strconv.Quote(string(src))
+	}
+	i := max(0, min(offset-excerptLen/2, len(src)-excerptLen))
+	s := strconv.Quote(string(src[i : i+excerptLen]))
+	if i > 0 {
+		s = "..." + s
+	}
+	if i+excerptLen < len(src) {
+		s += "..."
+	}
+	return s
+}
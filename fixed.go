@@ -0,0 +1,70 @@
+package base58
+
+import (
+	"fmt"
+	"slices"
+)
+
+// Uint64Width is the maximum length of the base58 encoding of an uint64.
+const Uint64Width = 11 // 58^10 < math.MaxUint64 < 58^11
+
+// EncodeUint64Fixed encodes the unsigned integer to the fixed width, padding
+// with the first character of the alphabet. Use Uint64Width to encode any
+// uint64 value. The encoded bytes preserve the numeric order in byte-wise
+// comparison when the encoding preserves order (see PreservesOrder).
+func (enc *Encoding) EncodeUint64Fixed(n uint64, width int) ([]byte, error) {
+	return enc.AppendEncodeUint64Fixed(make([]byte, 0, max(width, 0)), n, width)
+}
+
+// AppendEncodeUint64Fixed appends the fixed-width encoding of the unsigned
+// integer to dst, and returns the extended buffer.
+func (enc *Encoding) AppendEncodeUint64Fixed(dst []byte, n uint64, width int) ([]byte, error) {
+	if width < 0 {
+		return dst, fmt.Errorf("invalid width %d in encoding a fixed-width base58 string", width)
+	}
+	if n > enc.maxFixed(width) {
+		return dst, fmt.Errorf("%w: %d does not fit in %d base58 characters", ErrOverflow, n, width)
+	}
+	l := len(dst)
+	dst = slices.Grow(dst, width)[:l+width]
+	buf, i := enc.appendEncodeUint64(dst[l:], n)
+	for i > 0 {
+		i--
+		buf[i] = enc.alphabet[0]
+	}
+	return dst, nil
+}
+
+// maxFixed returns the maximum number which fits in the width.
+func (enc *Encoding) maxFixed(width int) uint64 {
+	if width >= Uint64Width {
+		return 1<<64 - 1
+	}
+	n := uint64(1)
+	for range width {
+		n *= radix
+	}
+	return n - 1
+}
+
+// DecodeUint64Fixed decodes the fixed-width base58 encoded bytes to an
+// unsigned integer.
+func (enc *Encoding) DecodeUint64Fixed(src []byte, width int) (uint64, error) {
+	if width < 0 {
+		return 0, fmt.Errorf("invalid width %d in decoding a fixed-width base58 string %s",
+			width, excerpt(src, 0))
+	}
+	if len(src) != width {
+		return 0, fmt.Errorf("invalid length %d in decoding a fixed-width base58 string %s (expected %d)",
+			len(src), excerpt(src, 0), width)
+	}
+	return enc.decodeUint(src, 0, 64)
+}
+
+// PreservesOrder reports whether the alphabet is in ascending byte order, so
+// that the byte-wise order of encoded bytes of the same length is the same as
+// the numeric order. BitcoinEncoding preserves order, but FlickrEncoding and
+// RippleEncoding do not.
+func (enc *Encoding) PreservesOrder() bool {
+	return slices.IsSorted(enc.alphabet[:])
+}
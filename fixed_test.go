@@ -0,0 +1,105 @@
+package base58
+
+import (
+	"bytes"
+	"errors"
+	"math"
+	"math/rand"
+	"slices"
+	"testing"
+)
+
+func TestEncodeUint64Fixed(t *testing.T) {
+	testCases := []struct {
+		n        uint64
+		width    int
+		expected string
+	}{
+		{0, Uint64Width, "11111111111"},
+		{57, Uint64Width, "1111111111z"},
+		{58, Uint64Width, "11111111121"},
+		{math.MaxUint64, Uint64Width, "jpXCZedGfVQ"},
+		{0, 1, "1"},
+		{57, 1, "z"},
+		{57, 3, "11z"},
+		{58*58 - 1, 2, "zz"},
+	}
+	for _, tc := range testCases {
+		got, err := BitcoinEncoding.EncodeUint64Fixed(tc.n, tc.width)
+		if err != nil {
+			t.Fatalf("Error occurred while encoding %d (%s).", tc.n, err)
+		}
+		if string(got) != tc.expected {
+			t.Errorf("EncodeUint64Fixed(%d, %d) = %s, want %s", tc.n, tc.width, got, tc.expected)
+		}
+		n, err := BitcoinEncoding.DecodeUint64Fixed(got, tc.width)
+		if err != nil {
+			t.Fatalf("Error occurred while decoding %s (%s).", got, err)
+		}
+		if n != tc.n {
+			t.Errorf("DecodeUint64Fixed(%s, %d) = %d, want %d", got, tc.width, n, tc.n)
+		}
+	}
+}
+
+func TestEncodeUint64Fixed_Error(t *testing.T) {
+	for _, tc := range []struct {
+		n     uint64
+		width int
+	}{{58, 1}, {58 * 58, 2}, {math.MaxUint64, 10}, {1, 0}} {
+		got, err := BitcoinEncoding.EncodeUint64Fixed(tc.n, tc.width)
+		if !errors.Is(err, ErrOverflow) {
+			t.Errorf("Overflow error should occur while encoding %d but got %s.", tc.n, got)
+		}
+	}
+	got, err := BitcoinEncoding.AppendEncodeUint64Fixed([]byte("prefix:"), 58, 1)
+	if err == nil || string(got) != "prefix:" {
+		t.Errorf("AppendEncodeUint64Fixed should not extend the buffer on error but got %s.", got)
+	}
+	_, err = BitcoinEncoding.DecodeUint64Fixed([]byte("z"), 2)
+	if expected := `invalid length 1 in decoding a fixed-width base58 string "z" (expected 2)`; err == nil || err.Error() != expected {
+		t.Errorf("expected: %v\ngot: %v", expected, err)
+	}
+	_, err = BitcoinEncoding.EncodeUint64Fixed(0, -1)
+	if expected := `invalid width -1 in encoding a fixed-width base58 string`; err == nil || err.Error() != expected {
+		t.Errorf("expected: %v\ngot: %v", expected, err)
+	}
+	_, err = BitcoinEncoding.DecodeUint64Fixed([]byte{}, -1)
+	if expected := `invalid width -1 in decoding a fixed-width base58 string ""`; err == nil || err.Error() != expected {
+		t.Errorf("expected: %v\ngot: %v", expected, err)
+	}
+}
+
+func TestEncodeUint64Fixed_Order(t *testing.T) {
+	ns := make([]uint64, 1000)
+	for i := range ns {
+		ns[i] = rand.Uint64() >> rand.Intn(64)
+	}
+	slices.Sort(ns)
+	var prev []byte
+	for _, n := range ns {
+		got, err := BitcoinEncoding.EncodeUint64Fixed(n, Uint64Width)
+		if err != nil {
+			t.Fatalf("Error occurred while encoding %d (%s).", n, err)
+		}
+		if bytes.Compare(prev, got) > 0 {
+			t.Errorf("EncodeUint64Fixed(%d) = %s should not precede %s", n, got, prev)
+		}
+		prev = got
+	}
+}
+
+func TestPreservesOrder(t *testing.T) {
+	for _, tc := range []struct {
+		encoding *Encoding
+		expected bool
+	}{
+		{FlickrEncoding, false},
+		{RippleEncoding, false},
+		{BitcoinEncoding, true},
+	} {
+		if got := tc.encoding.PreservesOrder(); got != tc.expected {
+			t.Errorf("PreservesOrder() = %v, want %v", got, tc.expected)
+		}
+	}
+}
@@ -0,0 +1,40 @@
+package ripemd160
+
+import (
+	"encoding/hex"
+	"strings"
+	"testing"
+)
+
+func TestSum(t *testing.T) {
+	testCases := []struct {
+		src, expected string
+	}{
+		{"", "9c1185a5c5e9fc54612808977ee8f548b2258d31"},
+		{"a", "0bdc9d2d256b3ee9daae347be6f4dc835a467ffe"},
+		{"abc", "8eb208f7e05d987a9b044a8e98c6b087f15a0bfc"},
+		{"message digest", "5d0689ef49d2fae572b881b123a85ffa21595f36"},
+		{"abcdefghijklmnopqrstuvwxyz", "f71c27109c692c1b56bbdceb5b9d2865b3708dbc"},
+		{"abcdbcdecdefdefgefghfghighijhijkijkljklmklmnlmnomnopnopq", "12a053384a9c0c88e405a06c27dcf49ada62eb2b"},
+		{strings.Repeat("A", 55), "c4cf09138ab0b859b70c321375557430649190b4"},
+		{strings.Repeat("A", 56), "6da64c99dd269139248fa73adfb40e19b8722196"},
+		{strings.Repeat("A", 64), "76b192ac74796f9d41597324bd348fbed13d0ef3"},
+		{strings.Repeat("a", 1000000), "52783243c1697bdbe16d37f97f68f08325dc1528"},
+	}
+	for _, tc := range testCases {
+		got := Sum([]byte(tc.src))
+		if hex.EncodeToString(got[:]) != tc.expected {
+			t.Errorf("Sum(%.16q) = %x, want %s", tc.src, got, tc.expected)
+		}
+		h := New()
+		for i := 0; i < len(tc.src); i += 7 {
+			h.Write([]byte(tc.src[i:min(i+7, len(tc.src))]))
+		}
+		if got := hex.EncodeToString(h.Sum(nil)); got != tc.expected {
+			t.Errorf("New().Sum(%.16q) = %s, want %s", tc.src, got, tc.expected)
+		}
+		if got := hex.EncodeToString(h.Sum(nil)); got != tc.expected {
+			t.Errorf("New().Sum(%.16q) should not change the state but got %s", tc.src, got)
+		}
+	}
+}
@@ -0,0 +1,84 @@
+package base58
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"unicode/utf8"
+)
+
+// multibaseEncodings are the base58 encodings in the multibase table.
+var multibaseEncodings = []struct {
+	code byte
+	enc  *Encoding
+}{
+	{'z', BitcoinEncoding},
+	{'Z', FlickrEncoding},
+}
+
+// multibaseNames are the names of the codes in the multibase table.
+var multibaseNames = map[rune]string{
+	0x00: "identity", '0': "base2", '7': "base8", '9': "base10",
+	'f': "base16", 'F': "base16upper",
+	'v': "base32hex", 'V': "base32hexupper", 't': "base32hexpad", 'T': "base32hexpadupper",
+	'b': "base32", 'B': "base32upper", 'c': "base32pad", 'C': "base32padupper",
+	'h': "base32z", 'k': "base36", 'K': "base36upper", 'R': "base45",
+	'z': "base58btc", 'Z': "base58flickr",
+	'm': "base64", 'M': "base64pad", 'u': "base64url", 'U': "base64urlpad",
+	'p': "proquint", '🚀': "base256emoji",
+}
+
+// ErrMultibaseEmpty is returned when a multibase string has no prefix code.
+var ErrMultibaseEmpty = errors.New("missing code in decoding a multibase string")
+
+// A MultibaseError is returned when the prefix code of a multibase string is
+// not of the base58 encodings.
+type MultibaseError struct {
+	Code rune   // prefix code
+	Name string // name of the code, or empty if the code is unknown
+}
+
+func (err *MultibaseError) Error() string {
+	if err.Name == "" {
+		return fmt.Sprintf("unknown code %q in decoding a multibase string", err.Code)
+	}
+	return fmt.Sprintf("unsupported code %q (%s) in decoding a multibase string", err.Code, err.Name)
+}
+
+// MultibaseEncode encodes the byte slice in the same way as EncodeBytes, with
+// the prefix code of the multibase table; 'z' for BitcoinEncoding (base58btc),
+// and 'Z' for FlickrEncoding (base58flickr). This method returns an error for
+// the encodings without the code.
+func (enc *Encoding) MultibaseEncode(src []byte) ([]byte, error) {
+	for _, m := range multibaseEncodings {
+		if enc.Equal(m.enc) {
+			return slices.Insert(enc.EncodeBytes(src), 0, m.code), nil
+		}
+	}
+	return nil, fmt.Errorf("no multibase code for the base58 encoding %s", enc)
+}
+
+// MultibaseDecode decodes the multibase string prefixed with 'z' (base58btc)
+// or 'Z' (base58flickr) in the same way as DecodeBytes, and returns the
+// encoding of the prefix code and the decoded bytes.
+func MultibaseDecode(src []byte) (*Encoding, []byte, error) {
+	if len(src) == 0 {
+		return nil, nil, ErrMultibaseEmpty
+	}
+	for _, m := range multibaseEncodings {
+		if src[0] == m.code {
+			for i := 1; i < len(src); i++ {
+				if m.enc.decodeMap[src[i]] < 0 {
+					return nil, nil, newCorruptInputError("base58", src, i)
+				}
+			}
+			buf, err := m.enc.DecodeBytes(src[1:])
+			if err != nil {
+				return nil, nil, err
+			}
+			return m.enc, buf, nil
+		}
+	}
+	code, _ := utf8.DecodeRune(src)
+	return nil, nil, &MultibaseError{Code: code, Name: multibaseNames[code]}
+}
@@ -0,0 +1,67 @@
+package base58
+
+import (
+	"encoding/hex"
+	"errors"
+	"testing"
+)
+
+func TestMultibaseEncode(t *testing.T) {
+	testCases := []struct {
+		enc      *Encoding
+		decoded  string
+		expected string
+	}{
+		{BitcoinEncoding, "", "z"},
+		{BitcoinEncoding, "00", "z1"},
+		{BitcoinEncoding, "68656c6c6f20776f726c64", "zStV1DL6CwTryKyV"},
+		{BitcoinEncoding, "00796573206d616e692021", "z17paNL19xttacUY"},
+		{FlickrEncoding, "68656c6c6f20776f726c64", "ZrTu1dk6cWsRYjYu"},
+		{New([]byte(BitcoinEncoding.Alphabet())), "0000ff", "z115Q"},
+	}
+	for _, tc := range testCases {
+		src, _ := hex.DecodeString(tc.decoded)
+		got, err := tc.enc.MultibaseEncode(src)
+		if err != nil {
+			t.Fatalf("Error occurred while encoding %s (%s).", tc.decoded, err)
+		}
+		if string(got) != tc.expected {
+			t.Errorf("MultibaseEncode(%s) = %s, want %s", tc.decoded, got, tc.expected)
+		}
+		enc, decoded, err := MultibaseDecode(got)
+		if err != nil {
+			t.Fatalf("Error occurred while decoding %s (%s).", got, err)
+		}
+		if !enc.Equal(tc.enc) || hex.EncodeToString(decoded) != tc.decoded {
+			t.Errorf("MultibaseDecode(%s) = %s, %x, want %s, %s", got, enc, decoded, tc.enc, tc.decoded)
+		}
+	}
+	if _, err := RippleEncoding.MultibaseEncode([]byte("foo")); err == nil {
+		t.Errorf("Error should occur while encoding with ripple encoding.")
+	}
+}
+
+func TestMultibaseDecode_Error(t *testing.T) {
+	testCases := []struct {
+		src      string
+		expected string
+	}{
+		{"", "missing code in decoding a multibase string"},
+		{"f68656c6c6f", `unsupported code 'f' (base16) in decoding a multibase string`},
+		{"mSGVsbG8", `unsupported code 'm' (base64) in decoding a multibase string`},
+		{"🚀🚀", `unsupported code '🚀' (base256emoji) in decoding a multibase string`},
+		{"QmYwAPJzv5CZsnA625s3Xf2nemtYgPpHdWEz79ojWnPbdG", `unknown code 'Q' in decoding a multibase string`},
+		{"zStV1DL6CwTryKyl", `invalid character 'l' at offset 15 in decoding a base58 string "zStV1DL6CwTryKyl"`},
+		{"Z0", `invalid character '0' at offset 1 in decoding a base58 string "Z0"`},
+	}
+	for _, tc := range testCases {
+		_, _, err := MultibaseDecode([]byte(tc.src))
+		if err == nil || err.Error() != tc.expected {
+			t.Errorf("expected: %v\ngot: %v", tc.expected, err)
+		}
+	}
+	_, _, err := MultibaseDecode([]byte("f00"))
+	if merr := (*MultibaseError)(nil); !errors.As(err, &merr) || merr.Code != 'f' || merr.Name != "base16" {
+		t.Errorf("expected MultibaseError but got: %v", err)
+	}
+}
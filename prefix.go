@@ -0,0 +1,57 @@
+package base58
+
+import (
+	"fmt"
+	"math/big"
+)
+
+// VersionPrefix returns the shortest version bytes such that the encodings
+// of the version bytes followed by any n bytes start with the tag, in the same
+// way as EncodeBytes. Add the checksum length to n for base58check. When there
+// are multiple version bytes of the shortest length, this method returns the
+// smallest one.
+func (enc *Encoding) VersionPrefix(tag string, n int) ([]byte, error) {
+	if tag == "" {
+		return nil, fmt.Errorf("empty tag in searching a version prefix")
+	}
+	if n < 0 {
+		return nil, fmt.Errorf("invalid payload length %d in searching a version prefix", n)
+	}
+	for i := range len(tag) {
+		if enc.decodeMap[tag[i]] < 0 {
+			return nil, newCorruptInputError("base58", []byte(tag), i)
+		}
+	}
+	// Each leading first character of the alphabet is a leading zero byte.
+	var zerocnt int
+	for zerocnt < len(tag) && tag[zerocnt] == enc.alphabet[0] {
+		zerocnt++
+	}
+	if zerocnt == len(tag) {
+		return make([]byte, zerocnt), nil
+	}
+	t := base58Converter.parse([]byte(tag[zerocnt:]), &enc.decodeMap)
+	t1 := new(big.Int).Add(t, big.NewInt(1))
+	m := new(big.Int).Lsh(big.NewInt(1), uint(n)*8) // number of payloads
+	lo, hi, p := new(big.Int), new(big.Int), new(big.Int)
+	for l := 1; ; l++ {
+		// The version bytes v of length l have no leading zero byte, and
+		// the numbers of the encodings starting with the tag are in
+		// [t*58^d, (t+1)*58^d) for some d, so v*m >= t*58^d and
+		// (v+1)*m <= (t+1)*58^d.
+		vmin := new(big.Int).Lsh(big.NewInt(1), uint(l-1)*8)
+		vmax := new(big.Int).Lsh(big.NewInt(1), uint(l)*8)
+		limit := new(big.Int).Mul(vmax, m)
+		for p.SetInt64(1); new(big.Int).Mul(t, p).Cmp(limit) < 0; p.Mul(p, big.NewInt(int64(radix))) {
+			lo.Mul(t, p)
+			lo.Add(lo, m).Sub(lo, big.NewInt(1)).Quo(lo, m) // ceil(t*58^d / m)
+			hi.Mul(t1, p).Quo(hi, m)                        // floor((t+1)*58^d / m)
+			if lo.Cmp(vmin) < 0 {
+				lo.Set(vmin)
+			}
+			if lo.Cmp(hi) < 0 && lo.Cmp(vmax) < 0 {
+				return append(make([]byte, zerocnt), lo.FillBytes(make([]byte, l))...), nil
+			}
+		}
+	}
+}
@@ -0,0 +1,109 @@
+package base58
+
+import (
+	"bytes"
+	"encoding/hex"
+	"math/rand"
+	"slices"
+	"strings"
+	"testing"
+)
+
+func TestVersionPrefix(t *testing.T) {
+	testCases := []struct {
+		enc      *Encoding
+		tag      string
+		n        int
+		expected string
+	}{
+		{BitcoinEncoding, "tz1", 24, "06a19f"},
+		{BitcoinEncoding, "KT1", 24, "025a79"},
+		{BitcoinEncoding, "edsig", 68, "09f5cd85b9"},
+		{BitcoinEncoding, "1", 20, "00"},
+		{BitcoinEncoding, "11", 0, "0000"},
+		{BitcoinEncoding, "r", 24, "7a"},
+		{RippleEncoding, "r", 24, "00"},
+		{FlickrEncoding, "Z", 0, "39"},
+	}
+	for _, tc := range testCases {
+		got, err := tc.enc.VersionPrefix(tc.tag, tc.n)
+		if err != nil {
+			t.Fatalf("Error occurred while searching a version prefix of %s (%s).", tc.tag, err)
+		}
+		if hex.EncodeToString(got) != tc.expected {
+			t.Errorf("VersionPrefix(%s, %d) = %x, want %s", tc.tag, tc.n, got, tc.expected)
+		}
+		for _, payload := range [][]byte{
+			make([]byte, tc.n), bytes.Repeat([]byte{0xff}, tc.n), randomBytes(tc.n),
+		} {
+			if encoded := tc.enc.EncodeBytes(slices.Concat(got, payload)); !strings.HasPrefix(string(encoded), tc.tag) {
+				t.Errorf("EncodeBytes(%x) = %s, should start with %s", slices.Concat(got, payload), encoded, tc.tag)
+			}
+		}
+	}
+}
+
+func TestVersionPrefix_BruteForce(t *testing.T) {
+	for range 100 {
+		enc := FlickrEncoding
+		tag := string(enc.alphabet[1+rand.Intn(57)])
+		if rand.Intn(2) == 0 {
+			tag += string(enc.alphabet[rand.Intn(58)])
+		}
+		n := rand.Intn(2)
+		got, err := enc.VersionPrefix(tag, n)
+		if err != nil {
+			t.Fatalf("Error occurred while searching a version prefix of %s (%s).", tag, err)
+		}
+		expected := bruteForceVersionPrefix(enc, tag, n)
+		if !bytes.Equal(got, expected) {
+			t.Errorf("VersionPrefix(%s, %d) = %x, want %x", tag, n, got, expected)
+		}
+	}
+}
+
+func bruteForceVersionPrefix(enc *Encoding, tag string, n int) []byte {
+	for l := 1; l <= 2; l++ {
+	L:
+		for v := 1 << ((l - 1) * 8); v < 1<<(l*8); v++ {
+			version := []byte{byte(v)}
+			if l == 2 {
+				version = []byte{byte(v >> 8), byte(v)}
+			}
+			for p := range 1 << (n * 8) {
+				payload := []byte{byte(p)}[:n]
+				if !strings.HasPrefix(string(enc.EncodeBytes(slices.Concat(version, payload))), tag) {
+					continue L
+				}
+			}
+			return version
+		}
+	}
+	return nil
+}
+
+func randomBytes(n int) []byte {
+	buf := make([]byte, n)
+	for i := range buf {
+		buf[i] = byte(rand.Intn(256))
+	}
+	return buf
+}
+
+func TestVersionPrefix_Error(t *testing.T) {
+	testCases := []struct {
+		tag      string
+		n        int
+		expected string
+	}{
+		{"", 20, "empty tag in searching a version prefix"},
+		{"tz1", -1, "invalid payload length -1 in searching a version prefix"},
+		{"tzl", 20, `invalid character 'l' at offset 2 in decoding a base58 string "tzl"`},
+	}
+	for _, tc := range testCases {
+		_, err := BitcoinEncoding.VersionPrefix(tc.tag, tc.n)
+		if err == nil || err.Error() != tc.expected {
+			t.Errorf("expected: %v\ngot: %v", tc.expected, err)
+		}
+	}
+}
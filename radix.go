@@ -0,0 +1,141 @@
+package base58
+
+import (
+	"bytes"
+	"fmt"
+	"math/bits"
+	"strconv"
+)
+
+// A RadixEncoding is an encoding/decoding scheme of an arbitrary radix, which
+// follows the same rules of leading zeros as Encoding. Use Encoding for the
+// base58 encoding, which is specialized for speed.
+type RadixEncoding struct {
+	alphabet  []byte
+	decodeMap [256]int64
+	conv      *bigConverter
+}
+
+// NewRadixEncoding creates a new encoding of the radix of the alphabet length,
+// which must be from 2 to 256, and the characters must be distinct.
+func NewRadixEncoding(alphabet []byte) (*RadixEncoding, error) {
+	if len(alphabet) < 2 || 256 < len(alphabet) {
+		return nil, fmt.Errorf("invalid alphabet length: expected 2 to 256 but got %d", len(alphabet))
+	}
+	enc := &RadixEncoding{
+		alphabet: bytes.Clone(alphabet),
+		conv:     newBigConverter(uint(len(alphabet))),
+	}
+	for i := range enc.decodeMap {
+		enc.decodeMap[i] = -1
+	}
+	for i, c := range enc.alphabet {
+		if j := enc.decodeMap[c]; j >= 0 {
+			return nil, fmt.Errorf("duplicate character '%c' at offsets %d and %d in alphabet", c, j, i)
+		}
+		enc.decodeMap[c] = int64(i)
+	}
+	return enc, nil
+}
+
+// Radix returns the radix of the encoding.
+func (enc *RadixEncoding) Radix() int {
+	return len(enc.alphabet)
+}
+
+// Encode encodes the number represented in the byte slice base 10.
+func (enc *RadixEncoding) Encode(src []byte) ([]byte, error) {
+	var zerocnt int
+	for zerocnt < len(src) && src[zerocnt] == '0' {
+		zerocnt++
+	}
+	buf := make([]byte, zerocnt, len(src))
+	for i := range buf {
+		buf[i] = enc.alphabet[0]
+	}
+	if len(src)-zerocnt < 20 { // 10^19 < math.MaxUint64 < 10^20
+		n, ok := parseUint64(src[zerocnt:])
+		if !ok {
+			return nil, newInvalidDigitError(src, 0)
+		}
+		if n == 0 {
+			return buf, nil
+		}
+		return enc.AppendEncodeUint64(buf, n), nil
+	}
+	for _, c := range src {
+		if decimalDecodeMap[c] < 0 {
+			return nil, newInvalidDigitError(src, 0)
+		}
+	}
+	x := base10Converter.parse(src[zerocnt:], &decimalDecodeMap)
+	digits := enc.conv.format(x, enc.alphabet)
+	return append(buf, trimLeadingZeros(digits, enc.alphabet[0])...), nil
+}
+
+// EncodeUint64 encodes the unsigned integer.
+func (enc *RadixEncoding) EncodeUint64(n uint64) []byte {
+	return enc.AppendEncodeUint64(nil, n)
+}
+
+// AppendEncodeUint64 appends the encoding of the unsigned integer to dst, and
+// returns the extended buffer.
+func (enc *RadixEncoding) AppendEncodeUint64(dst []byte, n uint64) []byte {
+	if n == 0 {
+		return append(dst, enc.alphabet[0])
+	}
+	var buf [64]byte
+	i, radix := len(buf), uint64(len(enc.alphabet))
+	for n > 0 {
+		i--
+		buf[i] = enc.alphabet[n%radix]
+		n /= radix
+	}
+	return append(dst, buf[i:]...)
+}
+
+// Decode decodes the encoded bytes to the number represented in base 10.
+func (enc *RadixEncoding) Decode(src []byte) ([]byte, error) {
+	if len(src) == 0 {
+		return []byte{}, nil
+	}
+	var zerocnt int
+	for zerocnt < len(src)-1 && src[zerocnt] == enc.alphabet[0] {
+		zerocnt++
+	}
+	buf := bytes.Repeat([]byte{'0'}, zerocnt)
+	if n, err := enc.DecodeUint64(src[zerocnt:]); err == nil {
+		return strconv.AppendUint(buf, n, 10), nil
+	}
+	for i, c := range src {
+		if enc.decodeMap[c] < 0 {
+			return nil, newCorruptInputError(enc.name(), src, i)
+		}
+	}
+	x := enc.conv.parse(src[zerocnt:], &enc.decodeMap)
+	digits := base10Converter.format(x, decimalAlphabet)
+	return append(buf, trimLeadingZeros(digits, '0')...), nil
+}
+
+// DecodeUint64 decodes the encoded bytes to an unsigned integer.
+func (enc *RadixEncoding) DecodeUint64(src []byte) (uint64, error) {
+	var n uint64
+	radix := uint64(len(enc.alphabet))
+	for k, c := range src {
+		i := enc.decodeMap[c]
+		if i < 0 {
+			return 0, newCorruptInputError(enc.name(), src, k)
+		}
+		hi, lo := bits.Mul64(n, radix)
+		lo, carry := bits.Add64(lo, uint64(i), 0)
+		if hi > 0 || carry > 0 {
+			return 0, overflowError(enc.name(), src, "uint64")
+		}
+		n = lo
+	}
+	return n, nil
+}
+
+func (enc *RadixEncoding) name() string {
+	return "base" + strconv.Itoa(len(enc.alphabet))
+}
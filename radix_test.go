@@ -0,0 +1,115 @@
+package base58
+
+import (
+	"math"
+	"math/big"
+	"math/rand"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+func TestRadixEncoding(t *testing.T) {
+	for _, radix := range []int{2, 8, 10, 16, 36} {
+		enc, err := NewRadixEncoding([]byte("0123456789abcdefghijklmnopqrstuvwxyz"[:radix]))
+		if err != nil {
+			t.Fatalf("Error occurred while creating an encoding (%s).", err)
+		}
+		if got := enc.Radix(); got != radix {
+			t.Errorf("Radix() = %d, want %d", got, radix)
+		}
+		for i := range 100 {
+			n := rand.Uint64() >> (i % 64)
+			got := enc.EncodeUint64(n)
+			if expected := strconv.FormatUint(n, radix); string(got) != expected {
+				t.Errorf("EncodeUint64(%d) = %s, want %s", n, got, expected)
+			}
+			m, err := enc.DecodeUint64(got)
+			if err != nil {
+				t.Fatalf("Error occurred while decoding %s (%s).", got, err)
+			}
+			if m != n {
+				t.Errorf("DecodeUint64(%s) = %d, want %d", got, m, n)
+			}
+		}
+		for _, n := range []int{1, 10, 19, 20, 50, 1000} {
+			x, _ := new(big.Int).SetString(string(randomDigits(n)), 10)
+			src := []byte("00" + x.String())
+			got, err := enc.Encode(src)
+			if err != nil {
+				t.Fatalf("Error occurred while encoding %s (%s).", src, err)
+			}
+			if expected := "00" + x.Text(radix); string(got) != expected {
+				t.Errorf("Encode(%s) = %s, want %s", src, got, expected)
+			}
+			decoded, err := enc.Decode(got)
+			if err != nil {
+				t.Fatalf("Error occurred while decoding %s (%s).", got, err)
+			}
+			if string(decoded) != string(src) {
+				t.Errorf("Decode(%s) = %s, want %s", got, decoded, src)
+			}
+		}
+	}
+}
+
+func TestRadixEncoding_Base58(t *testing.T) {
+	for _, testcase := range testcases {
+		enc, err := NewRadixEncoding(testcase.encoding.alphabet[:])
+		if err != nil {
+			t.Fatalf("Error occurred while creating an encoding (%s).", err)
+		}
+		for _, pair := range testcase.testpairs {
+			got, err := enc.Encode([]byte(pair.decoded))
+			if err != nil {
+				t.Fatalf("Error occurred while encoding %s (%s).", pair.decoded, err)
+			}
+			if string(got) != pair.encoded {
+				t.Errorf("Encode(%s) = %s, want %s", pair.decoded, got, pair.encoded)
+			}
+			got, err = enc.Decode([]byte(pair.encoded))
+			if err != nil {
+				t.Fatalf("Error occurred while decoding %s (%s).", pair.encoded, err)
+			}
+			if string(got) != pair.decoded {
+				t.Errorf("Decode(%s) = %s, want %s", pair.encoded, got, pair.decoded)
+			}
+		}
+	}
+}
+
+func TestRadixEncoding_Base256(t *testing.T) {
+	alphabet := make([]byte, 256)
+	for i := range alphabet {
+		alphabet[i] = byte(i)
+	}
+	enc, err := NewRadixEncoding(alphabet)
+	if err != nil {
+		t.Fatalf("Error occurred while creating an encoding (%s).", err)
+	}
+	got := enc.EncodeUint64(math.MaxUint64)
+	if expected := strings.Repeat("\xff", 8); string(got) != expected {
+		t.Errorf("EncodeUint64(%d) = %q, want %q", uint64(math.MaxUint64), got, expected)
+	}
+	if n, err := enc.DecodeUint64(append(got, 0)); err == nil {
+		t.Errorf("Overflow error should occur while decoding %q but got %d.", got, n)
+	}
+}
+
+func TestNewRadixEncoding_Error(t *testing.T) {
+	testCases := []struct {
+		alphabet string
+		err      string
+	}{
+		{"0", "invalid alphabet length: expected 2 to 256 but got 1"},
+		{"0120", "duplicate character '0' at offsets 0 and 3 in alphabet"},
+	}
+	for _, tc := range testCases {
+		_, err := NewRadixEncoding([]byte(tc.alphabet))
+		if err == nil {
+			t.Errorf("Error should occur while creating an encoding %q.", tc.alphabet)
+		} else if got := err.Error(); got != tc.err {
+			t.Errorf("expected: %v\ngot: %v", tc.err, got)
+		}
+	}
+}
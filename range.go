@@ -0,0 +1,93 @@
+package base58
+
+import (
+	"fmt"
+	"math"
+	"math/big"
+)
+
+// PrefixRange returns the inclusive range of the numbers of which the
+// encodings padded to the width with the first character of the alphabet (see
+// EncodeUint64Fixed) start with the prefix. The range is computed from the
+// digit values, so it works with the alphabets which do not preserve order.
+func (enc *Encoding) PrefixRange(prefix []byte, width int) (lo, hi *big.Int, err error) {
+	if err := enc.validatePrefix(prefix, width); err != nil {
+		return nil, nil, err
+	}
+	lo, hi = enc.prefixRange(prefix, width)
+	return lo, hi, nil
+}
+
+// prefixRange returns [p*58^(width-len(prefix)), (p+1)*58^(width-len(prefix))-1]
+// where p is the number of the prefix.
+func (enc *Encoding) prefixRange(prefix []byte, width int) (*big.Int, *big.Int) {
+	lo := base58Converter.parse(prefix, &enc.decodeMap)
+	hi := new(big.Int).Add(lo, big.NewInt(1))
+	pow := new(big.Int).Exp(big.NewInt(int64(radix)), big.NewInt(int64(width-len(prefix))), nil)
+	lo.Mul(lo, pow)
+	hi.Mul(hi, pow).Sub(hi, big.NewInt(1))
+	return lo, hi
+}
+
+func (enc *Encoding) validatePrefix(prefix []byte, width int) error {
+	for i, c := range prefix {
+		if enc.decodeMap[c] < 0 {
+			return newCorruptInputError("base58", prefix, i)
+		}
+	}
+	if width < len(prefix) {
+		return fmt.Errorf("invalid width %d for a base58 prefix %s", width, excerpt(prefix, 0))
+	}
+	return nil
+}
+
+// A Uint64Range is an inclusive range of unsigned integers.
+type Uint64Range struct {
+	Min, Max uint64
+}
+
+// PrefixRangesUint64 returns the inclusive ranges of the unsigned integers of
+// which the encodings start with the prefix. When the width is positive, the
+// numbers are encoded to the width in the same way as EncodeUint64Fixed, and
+// the result has at most one range. When the width is zero, the numbers are
+// encoded in the same way as EncodeUint64, and the result has a range for
+// each encoded length in ascending order.
+func (enc *Encoding) PrefixRangesUint64(prefix []byte, width int) ([]Uint64Range, error) {
+	if width != 0 {
+		if err := enc.validatePrefix(prefix, width); err != nil {
+			return nil, err
+		}
+		lo, hi := enc.prefixRange(prefix, width)
+		return appendUint64Range([]Uint64Range{}, lo, hi), nil
+	}
+	if err := enc.validatePrefix(prefix, len(prefix)); err != nil {
+		return nil, err
+	}
+	if len(prefix) == 0 {
+		return []Uint64Range{{0, math.MaxUint64}}, nil
+	}
+	if prefix[0] == enc.alphabet[0] {
+		if len(prefix) == 1 {
+			return []Uint64Range{{0, 0}}, nil
+		}
+		return []Uint64Range{}, nil
+	}
+	rs := []Uint64Range{}
+	for width := len(prefix); width <= Uint64Width; width++ {
+		lo, hi := enc.prefixRange(prefix, width)
+		rs = appendUint64Range(rs, lo, hi)
+	}
+	return rs, nil
+}
+
+// appendUint64Range appends the range clipped to uint64 if not empty.
+func appendUint64Range(rs []Uint64Range, lo, hi *big.Int) []Uint64Range {
+	if !lo.IsUint64() {
+		return rs
+	}
+	r := Uint64Range{lo.Uint64(), math.MaxUint64}
+	if hi.IsUint64() {
+		r.Max = hi.Uint64()
+	}
+	return append(rs, r)
+}
@@ -0,0 +1,136 @@
+package base58
+
+import (
+	"bytes"
+	"math"
+	"math/rand"
+	"slices"
+	"testing"
+)
+
+func TestPrefixRange(t *testing.T) {
+	testCases := []struct {
+		enc      *Encoding
+		prefix   string
+		width    int
+		min, max string
+	}{
+		{BitcoinEncoding, "", 0, "0", "0"},
+		{BitcoinEncoding, "", 1, "0", "57"},
+		{BitcoinEncoding, "2", 1, "1", "1"},
+		{BitcoinEncoding, "2", 2, "58", "115"},
+		{BitcoinEncoding, "1", 2, "0", "57"},
+		{BitcoinEncoding, "jpXCZedGfVQ", 11, "18446744073709551615", "18446744073709551615"},
+		{BitcoinEncoding, "jpXCZedGfVR", 11, "18446744073709551616", "18446744073709551616"},
+		{BitcoinEncoding, "z", 12, "1424238708009435654144", "1449225352009601191935"},
+		{RippleEncoding, "r", 2, "0", "57"},
+		{RippleEncoding, "p", 2, "58", "115"},
+	}
+	for _, tc := range testCases {
+		lo, hi, err := tc.enc.PrefixRange([]byte(tc.prefix), tc.width)
+		if err != nil {
+			t.Fatalf("Error occurred while computing the range of %s (%s).", tc.prefix, err)
+		}
+		if lo.String() != tc.min || hi.String() != tc.max {
+			t.Errorf("PrefixRange(%s, %d) = %s, %s, want %s, %s", tc.prefix, tc.width, lo, hi, tc.min, tc.max)
+		}
+	}
+}
+
+func TestPrefixRangesUint64(t *testing.T) {
+	testCases := []struct {
+		enc      *Encoding
+		prefix   string
+		width    int
+		expected []Uint64Range
+	}{
+		{BitcoinEncoding, "", 0, []Uint64Range{{0, math.MaxUint64}}},
+		{BitcoinEncoding, "1", 0, []Uint64Range{{0, 0}}},
+		{BitcoinEncoding, "11", 0, []Uint64Range{}},
+		{BitcoinEncoding, "2", 1, []Uint64Range{{1, 1}}},
+		{BitcoinEncoding, "11", 3, []Uint64Range{{0, 57}}},
+		{BitcoinEncoding, "jpXCZedGfVQ", 11, []Uint64Range{{math.MaxUint64, math.MaxUint64}}},
+		{BitcoinEncoding, "jpXCZedGfVR", 11, []Uint64Range{}},
+		{BitcoinEncoding, "jpXCZedGfV", 11, []Uint64Range{{18446744073709551592, math.MaxUint64}}},
+		{BitcoinEncoding, "z", 12, []Uint64Range{}},
+		{BitcoinEncoding, "jq", 0, []Uint64Range{
+			{2484, 2484}, {144072, 144129}, {8356176, 8359539}, {484658208, 484853319},
+			{28110176064, 28121492559}, {1630390211712, 1631046568479},
+			{94562632279296, 94600700971839}, {5484632672199168, 5486840656366719},
+			{318108694987551744, 318236758069269759},
+		}},
+		{RippleEncoding, "p", 0, []Uint64Range{
+			{1, 1}, {58, 115}, {3364, 6727}, {195112, 390223}, {11316496, 22632991},
+			{656356768, 1312713535}, {38068692544, 76137385087}, {2207984167552, 4415968335103},
+			{128063081718016, 256126163436031}, {7427658739644928, 14855317479289855},
+			{430804206899405824, 861608413798811647},
+		}},
+	}
+	for _, tc := range testCases {
+		got, err := tc.enc.PrefixRangesUint64([]byte(tc.prefix), tc.width)
+		if err != nil {
+			t.Fatalf("Error occurred while computing the ranges of %s (%s).", tc.prefix, err)
+		}
+		if !slices.Equal(got, tc.expected) {
+			t.Errorf("PrefixRangesUint64(%s, %d) = %v, want %v", tc.prefix, tc.width, got, tc.expected)
+		}
+	}
+}
+
+func TestPrefixRangesUint64_Random(t *testing.T) {
+	for _, enc := range []*Encoding{FlickrEncoding, RippleEncoding, BitcoinEncoding} {
+		for range 100 {
+			n := rand.Uint64() >> rand.Intn(64)
+			encoded := enc.EncodeUint64(n)
+			prefix := encoded[:1+rand.Intn(len(encoded))]
+			other := enc.EncodeUint64(rand.Uint64() >> rand.Intn(64))
+			rs, err := enc.PrefixRangesUint64(prefix, 0)
+			if err != nil {
+				t.Fatalf("Error occurred while computing the ranges of %s (%s).", prefix, err)
+			}
+			if !containsUint64(rs, n) {
+				t.Errorf("PrefixRangesUint64(%s, 0) = %v should contain %d", prefix, rs, n)
+			}
+			m, _ := enc.DecodeUint64(other)
+			if bytes.HasPrefix(other, prefix) != containsUint64(rs, m) {
+				t.Errorf("PrefixRangesUint64(%s, 0) = %v for %s (%d)", prefix, rs, other, m)
+			}
+			fixed, _ := enc.EncodeUint64Fixed(n, Uint64Width)
+			rs, err = enc.PrefixRangesUint64(fixed[:len(prefix)], Uint64Width)
+			if err != nil {
+				t.Fatalf("Error occurred while computing the ranges of %s (%s).", fixed, err)
+			}
+			if len(rs) != 1 || !containsUint64(rs, n) {
+				t.Errorf("PrefixRangesUint64(%s, %d) = %v should contain %d", fixed[:len(prefix)], Uint64Width, rs, n)
+			}
+		}
+	}
+}
+
+func containsUint64(rs []Uint64Range, n uint64) bool {
+	return slices.ContainsFunc(rs, func(r Uint64Range) bool {
+		return r.Min <= n && n <= r.Max
+	})
+}
+
+func TestPrefixRange_Error(t *testing.T) {
+	testCases := []struct {
+		prefix   string
+		width    int
+		expected string
+	}{
+		{"abc", 2, `invalid width 2 for a base58 prefix "abc"`},
+		{"", -1, `invalid width -1 for a base58 prefix ""`},
+		{"abl", 3, `invalid character 'l' at offset 2 in decoding a base58 string "abl"`},
+	}
+	for _, tc := range testCases {
+		_, _, err := BitcoinEncoding.PrefixRange([]byte(tc.prefix), tc.width)
+		if err == nil || err.Error() != tc.expected {
+			t.Errorf("expected: %v\ngot: %v", tc.expected, err)
+		}
+		_, err = BitcoinEncoding.PrefixRangesUint64([]byte(tc.prefix), tc.width)
+		if err == nil || err.Error() != tc.expected {
+			t.Errorf("expected: %v\ngot: %v", tc.expected, err)
+		}
+	}
+}
@@ -0,0 +1,126 @@
+package base58
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
+)
+
+var registry = struct {
+	sync.RWMutex
+	encodings map[string]*Encoding
+	names     []string
+}{encodings: map[string]*Encoding{}}
+
+func init() {
+	Register("flickr", FlickrEncoding)
+	Register("ripple", RippleEncoding, "xrp")
+	Register("bitcoin", BitcoinEncoding, "btc", "ipfs")
+}
+
+// Register makes the encoding available by the name and the aliases. This
+// function panics if the name or an alias is already registered.
+func Register(name string, enc *Encoding, aliases ...string) {
+	if enc == nil {
+		panic("base58: Register encoding is nil")
+	}
+	names := append([]string{name}, aliases...)
+	registry.Lock()
+	defer registry.Unlock()
+	for _, name := range names {
+		if _, ok := registry.encodings[name]; ok {
+			panic("base58: Register called twice for encoding " + name)
+		}
+	}
+	for _, name := range names {
+		registry.encodings[name] = enc
+	}
+	registry.names = append(registry.names, name)
+}
+
+// Lookup returns the encoding registered by the name or an alias.
+func Lookup(name string) (*Encoding, bool) {
+	registry.RLock()
+	defer registry.RUnlock()
+	enc, ok := registry.encodings[name]
+	return enc, ok
+}
+
+// Names returns the names of the registered encodings in the registration
+// order, excluding the aliases.
+func Names() []string {
+	registry.RLock()
+	defer registry.RUnlock()
+	return slices.Clone(registry.names)
+}
+
+// UnmarshalFlag implements flags.Unmarshaler
+func (enc *Encoding) UnmarshalFlag(value string) error {
+	e, ok := Lookup(value)
+	if !ok {
+		return fmt.Errorf("expected one of [%s] but got %s", strings.Join(Names(), ", "), value)
+	}
+	*enc = *e
+	return nil
+}
+
+// Alphabet returns the alphabet of the encoding.
+func (enc *Encoding) Alphabet() string {
+	return string(enc.alphabet[:])
+}
+
+// Equal reports whether the encodings have the same alphabet. Nil encodings
+// are equal only to nil.
+func (enc *Encoding) Equal(other *Encoding) bool {
+	if enc == nil || other == nil {
+		return enc == other
+	}
+	return enc.alphabet == other.alphabet
+}
+
+// String returns the registered name of the encoding, or the alphabet if the
+// encoding is not registered. This method implements fmt.Stringer.
+func (enc *Encoding) String() string {
+	if enc == nil || enc.alphabet == [len(enc.alphabet)]byte{} {
+		return ""
+	}
+	registry.RLock()
+	defer registry.RUnlock()
+	for _, name := range registry.names {
+		if enc.Equal(registry.encodings[name]) {
+			return name
+		}
+	}
+	return enc.Alphabet()
+}
+
+// Set sets the encoding by the registered name or the alphabet. This method
+// implements flag.Value.
+func (enc *Encoding) Set(value string) error {
+	return enc.UnmarshalText([]byte(value))
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (enc *Encoding) MarshalText() ([]byte, error) {
+	return []byte(enc.String()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. The text is either the
+// registered name or the alphabet of the encoding.
+func (enc *Encoding) UnmarshalText(text []byte) error {
+	if e, ok := Lookup(string(text)); ok {
+		*enc = *e
+		return nil
+	}
+	if len(text) != len(enc.alphabet) {
+		return fmt.Errorf("expected one of [%s] or an alphabet but got %s",
+			strings.Join(Names(), ", "), text)
+	}
+	e, err := NewEncoding(text)
+	if err != nil {
+		return err
+	}
+	*enc = *e
+	return nil
+}
@@ -0,0 +1,161 @@
+package base58
+
+import (
+	"encoding/json"
+	"flag"
+	"io"
+	"maps"
+	"slices"
+	"strings"
+	"testing"
+)
+
+func TestLookup(t *testing.T) {
+	testCases := []struct {
+		name     string
+		expected *Encoding
+	}{
+		{"flickr", FlickrEncoding},
+		{"ripple", RippleEncoding},
+		{"xrp", RippleEncoding},
+		{"bitcoin", BitcoinEncoding},
+		{"btc", BitcoinEncoding},
+		{"ipfs", BitcoinEncoding},
+		{"foo", nil},
+	}
+	for _, tc := range testCases {
+		got, ok := Lookup(tc.name)
+		if got != tc.expected || ok != (tc.expected != nil) {
+			t.Errorf("Lookup(%s) = %p, %v, want %p", tc.name, got, ok, tc.expected)
+		}
+	}
+}
+
+func TestRegister(t *testing.T) {
+	registry.RLock()
+	encodings, names := maps.Clone(registry.encodings), slices.Clone(registry.names)
+	registry.RUnlock()
+	t.Cleanup(func() {
+		registry.Lock()
+		defer registry.Unlock()
+		registry.encodings, registry.names = encodings, names
+	})
+	enc, _ := NewEncoding([]byte("ABCDEFGHJKLMNPQRSTUVWXYZ123456789abcdefghijkmnopqrstuvwxyz"))
+	Register("test-register", enc, "test-register-alias")
+	if got, _ := Lookup("test-register-alias"); got != enc {
+		t.Errorf("Lookup(%s) = %p, want %p", "test-register-alias", got, enc)
+	}
+	if got, expected := Names(), []string{"flickr", "ripple", "bitcoin", "test-register"}; !slices.Equal(got, expected) {
+		t.Errorf("Names() = %v, want %v", got, expected)
+	}
+	func() {
+		defer func() {
+			if recover() == nil {
+				t.Errorf("Register should panic for a registered name.")
+			}
+		}()
+		Register("test-register-2", enc, "btc")
+	}()
+	if _, ok := Lookup("test-register-2"); ok {
+		t.Errorf("Register should not register names on panic.")
+	}
+	var got Encoding
+	if err := got.UnmarshalFlag("test-register"); err != nil || got != *enc {
+		t.Errorf("UnmarshalFlag(%s) = %v", "test-register", err)
+	}
+	if err := got.UnmarshalFlag("foo"); err == nil {
+		t.Errorf("Error should occur while unmarshaling %s.", "foo")
+	}
+}
+
+func TestEncoding_Text(t *testing.T) {
+	custom, _ := NewEncoding([]byte("zyxwvutsrqponmkjihgfedcbaZYXWVUTSRQPNMLKJHGFEDCBA987654321"))
+	testCases := []struct {
+		encoding *Encoding
+		text     string
+	}{
+		{FlickrEncoding, "flickr"},
+		{RippleEncoding, "ripple"},
+		{BitcoinEncoding, "bitcoin"},
+		{New([]byte(BitcoinEncoding.Alphabet())), "bitcoin"},
+		{custom, "zyxwvutsrqponmkjihgfedcbaZYXWVUTSRQPNMLKJHGFEDCBA987654321"},
+		{&Encoding{}, ""},
+	}
+	for _, tc := range testCases {
+		if got := tc.encoding.String(); got != tc.text {
+			t.Errorf("String() = %s, want %s", got, tc.text)
+		}
+		text, err := tc.encoding.MarshalText()
+		if err != nil || string(text) != tc.text {
+			t.Errorf("MarshalText() = %s, %v, want %s", text, err, tc.text)
+		}
+		if tc.text == "" {
+			continue
+		}
+		var enc Encoding
+		if err := enc.UnmarshalText(text); err != nil {
+			t.Fatalf("Error occurred while unmarshaling %s (%s).", text, err)
+		}
+		if !enc.Equal(tc.encoding) {
+			t.Errorf("UnmarshalText(%s) = %s, want %s", text, enc.Alphabet(), tc.encoding.Alphabet())
+		}
+	}
+}
+
+func TestEncoding_Equal(t *testing.T) {
+	var nilEncoding *Encoding
+	testCases := []struct {
+		enc, other *Encoding
+		expected   bool
+	}{
+		{BitcoinEncoding, New([]byte(BitcoinEncoding.Alphabet())), true},
+		{BitcoinEncoding, FlickrEncoding, false},
+		{BitcoinEncoding, nil, false},
+		{nilEncoding, BitcoinEncoding, false},
+		{nilEncoding, nil, true},
+	}
+	for _, tc := range testCases {
+		if got := tc.enc.Equal(tc.other); got != tc.expected {
+			t.Errorf("Equal(%s, %s) = %v, want %v", tc.enc, tc.other, got, tc.expected)
+		}
+	}
+}
+
+func TestEncoding_JSON(t *testing.T) {
+	var config struct {
+		Encoding *Encoding `json:"encoding"`
+	}
+	if err := json.Unmarshal([]byte(`{"encoding":"btc"}`), &config); err != nil {
+		t.Fatalf("Error occurred while unmarshaling (%s).", err)
+	}
+	if !config.Encoding.Equal(BitcoinEncoding) {
+		t.Errorf("expected: %s\ngot: %s", BitcoinEncoding, config.Encoding)
+	}
+	got, err := json.Marshal(config)
+	if expected := `{"encoding":"bitcoin"}`; err != nil || string(got) != expected {
+		t.Errorf("expected: %s\ngot: %s", expected, got)
+	}
+	err = json.Unmarshal([]byte(`{"encoding":"foo"}`), &config)
+	if expected := "expected one of [flickr, ripple, bitcoin"; err == nil || !strings.HasPrefix(err.Error(), expected) {
+		t.Errorf("expected: %v\ngot: %v", expected, err)
+	}
+}
+
+func TestEncoding_FlagValue(t *testing.T) {
+	enc := *FlickrEncoding
+	fs := flag.NewFlagSet("test", flag.ContinueOnError)
+	fs.Var(&enc, "encoding", "encoding name")
+	if err := fs.Parse([]string{"-encoding", "ripple"}); err != nil {
+		t.Fatalf("Error occurred while parsing flags (%s).", err)
+	}
+	if !enc.Equal(RippleEncoding) {
+		t.Errorf("expected: %s\ngot: %s", RippleEncoding, &enc)
+	}
+	if got := fs.Lookup("encoding").DefValue; got != "flickr" {
+		t.Errorf("expected: %s\ngot: %s", "flickr", got)
+	}
+	fs.SetOutput(io.Discard)
+	if err := fs.Parse([]string{"-encoding", "12345"}); err == nil {
+		t.Errorf("Error should occur while parsing an invalid encoding.")
+	}
+}
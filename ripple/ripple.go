@@ -0,0 +1,185 @@
+// Package ripple implements the identifiers of the XRP Ledger, which are
+// encoded in base58check with RippleEncoding.
+package ripple
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+
+	"github.com/itchyny/base58-go"
+)
+
+// Sizes of the payloads.
+const (
+	AccountIDSize     = 20
+	NodePublicKeySize = 33
+	SeedSize          = 16
+)
+
+var (
+	accountIDVersion     = []byte{0x00}
+	nodePublicKeyVersion = []byte{0x1c}
+	secp256k1SeedVersion = []byte{0x21}
+	ed25519SeedVersion   = []byte{0x01, 0xe1, 0x4b}
+	xAddressVersion      = []byte{0x05, 0x44}
+	xAddressTestVersion  = []byte{0x04, 0x93}
+)
+
+// EncodeAccountID encodes the classic address of the account ID.
+func EncodeAccountID(id [AccountIDSize]byte) string {
+	return string(base58.RippleEncoding.CheckEncode(accountIDVersion, id[:]))
+}
+
+// DecodeAccountID decodes the classic address to the account ID.
+func DecodeAccountID(addr string) ([AccountIDSize]byte, error) {
+	payload, err := decode(addr, accountIDVersion, AccountIDSize, "ripple address")
+	if err != nil {
+		return [AccountIDSize]byte{}, err
+	}
+	return [AccountIDSize]byte(payload), nil
+}
+
+// EncodeNodePublicKey encodes the public key of a node.
+func EncodeNodePublicKey(key [NodePublicKeySize]byte) string {
+	return string(base58.RippleEncoding.CheckEncode(nodePublicKeyVersion, key[:]))
+}
+
+// DecodeNodePublicKey decodes the public key of a node.
+func DecodeNodePublicKey(key string) ([NodePublicKeySize]byte, error) {
+	payload, err := decode(key, nodePublicKeyVersion, NodePublicKeySize, "ripple node public key")
+	if err != nil {
+		return [NodePublicKeySize]byte{}, err
+	}
+	return [NodePublicKeySize]byte(payload), nil
+}
+
+func decode(src string, version []byte, size int, name string) ([]byte, error) {
+	payload, err := base58.RippleEncoding.CheckDecodeVersion([]byte(src), version)
+	if err != nil {
+		return nil, err
+	}
+	if len(payload) != size {
+		return nil, fmt.Errorf("invalid length %d in decoding a %s (expected %d)",
+			len(payload), name, size)
+	}
+	return payload, nil
+}
+
+// A KeyType is the algorithm of the key pair derived from a seed.
+type KeyType int
+
+const (
+	// Secp256k1 is the key type of the ECDSA signatures over secp256k1.
+	Secp256k1 KeyType = iota
+	// Ed25519 is the key type of the Ed25519 signatures.
+	Ed25519
+)
+
+func (typ KeyType) String() string {
+	switch typ {
+	case Secp256k1:
+		return "secp256k1"
+	case Ed25519:
+		return "ed25519"
+	default:
+		return fmt.Sprintf("KeyType(%d)", int(typ))
+	}
+}
+
+func (typ KeyType) version() []byte {
+	if typ == Ed25519 {
+		return ed25519SeedVersion
+	}
+	return secp256k1SeedVersion
+}
+
+// EncodeSeed encodes the family seed of the key type. The encoded seeds start
+// with "s", and the seeds of Ed25519 start with "sEd".
+func EncodeSeed(seed [SeedSize]byte, typ KeyType) string {
+	return string(base58.RippleEncoding.CheckEncode(typ.version(), seed[:]))
+}
+
+// DecodeSeed decodes the family seed, and reports the key type by the version
+// prefix. The error messages do not contain the input nor the decoded bytes.
+func DecodeSeed(seed string) ([SeedSize]byte, KeyType, error) {
+	_, buf, err := base58.RippleEncoding.CheckDecode([]byte(seed), 0)
+	if err != nil {
+		if cerr := (*base58.CorruptInputError)(nil); errors.As(err, &cerr) {
+			err = cerr.Redact()
+		}
+		return [SeedSize]byte{}, 0, err
+	}
+	defer clear(buf)
+	for _, typ := range []KeyType{Ed25519, Secp256k1} {
+		version := typ.version()
+		if len(buf) == len(version)+SeedSize && bytes.HasPrefix(buf, version) {
+			return [SeedSize]byte(buf[len(version):]), typ, nil
+		}
+	}
+	return [SeedSize]byte{}, 0, errors.New("unknown version or invalid length in decoding a ripple seed")
+}
+
+// An XAddress is an address which packs the account ID, the destination tag and
+// the network flag.
+type XAddress struct {
+	AccountID [AccountIDSize]byte
+	Tag       uint32
+	HasTag    bool // whether the address has the destination tag
+	Test      bool // whether the address is for the test network
+}
+
+// xAddressSize is the size of the payload of X-addresses; the account ID, the
+// flag of the tag, the tag in little endian, and the reserved four bytes.
+const xAddressSize = AccountIDSize + 1 + 8
+
+// String returns the base58check encoding of the X-address.
+func (addr *XAddress) String() string {
+	version := xAddressVersion
+	if addr.Test {
+		version = xAddressTestVersion
+	}
+	payload := make([]byte, xAddressSize)
+	copy(payload, addr.AccountID[:])
+	if addr.HasTag {
+		payload[AccountIDSize] = 1
+		binary.LittleEndian.PutUint32(payload[AccountIDSize+1:], addr.Tag)
+	}
+	return string(base58.RippleEncoding.CheckEncode(version, payload))
+}
+
+// ClassicAddress returns the classic address of the account ID.
+func (addr *XAddress) ClassicAddress() string {
+	return EncodeAccountID(addr.AccountID)
+}
+
+// DecodeXAddress decodes the X-address.
+func DecodeXAddress(addr string) (*XAddress, error) {
+	version, payload, err := base58.RippleEncoding.CheckDecode([]byte(addr), len(xAddressVersion))
+	if err != nil {
+		return nil, err
+	}
+	var test bool
+	switch {
+	case bytes.Equal(version, xAddressVersion):
+	case bytes.Equal(version, xAddressTestVersion):
+		test = true
+	default:
+		return nil, fmt.Errorf("unknown version %x in decoding a ripple X-address", version)
+	}
+	if len(payload) != xAddressSize {
+		return nil, fmt.Errorf("invalid length %d in decoding a ripple X-address (expected %d)",
+			len(payload), xAddressSize)
+	}
+	flag, tag := payload[AccountIDSize], binary.LittleEndian.Uint64(payload[AccountIDSize+1:])
+	if flag > 1 || flag == 0 && tag != 0 || tag > 1<<32-1 {
+		return nil, fmt.Errorf("invalid destination tag in decoding a ripple X-address")
+	}
+	return &XAddress{
+		AccountID: [AccountIDSize]byte(payload),
+		Tag:       uint32(tag),
+		HasTag:    flag == 1,
+		Test:      test,
+	}, nil
+}
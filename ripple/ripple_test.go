@@ -0,0 +1,170 @@
+package ripple
+
+import (
+	"encoding/hex"
+	"errors"
+	"testing"
+
+	"github.com/itchyny/base58-go"
+)
+
+func TestAccountID(t *testing.T) {
+	id, _ := hex.DecodeString("ba8e78626ee42c41b46d46c3048df3a1c3c87072")
+	expected := "rJrRMgiRgrU6hDF4pgu5DXQdWyPbY35ErN"
+	if got := EncodeAccountID([AccountIDSize]byte(id)); got != expected {
+		t.Errorf("EncodeAccountID(%x) = %s, want %s", id, got, expected)
+	}
+	got, err := DecodeAccountID(expected)
+	if err != nil {
+		t.Fatalf("Error occurred while decoding %s (%s).", expected, err)
+	}
+	if hex.EncodeToString(got[:]) != hex.EncodeToString(id) {
+		t.Errorf("DecodeAccountID(%s) = %x, want %x", expected, got, id)
+	}
+}
+
+func TestNodePublicKey(t *testing.T) {
+	key, _ := hex.DecodeString("0388e5ba87a000cb807240df8c848eb0b5ffa5c8e5a521bc8e105c0f0a44217828")
+	expected := "n9MXXueo837zYH36DvMc13BwHcqtfAWNJY5czWVbp7uYTj7x17TH"
+	if got := EncodeNodePublicKey([NodePublicKeySize]byte(key)); got != expected {
+		t.Errorf("EncodeNodePublicKey(%x) = %s, want %s", key, got, expected)
+	}
+	got, err := DecodeNodePublicKey(expected)
+	if err != nil {
+		t.Fatalf("Error occurred while decoding %s (%s).", expected, err)
+	}
+	if hex.EncodeToString(got[:]) != hex.EncodeToString(key) {
+		t.Errorf("DecodeNodePublicKey(%s) = %x, want %x", expected, got, key)
+	}
+}
+
+func TestSeed(t *testing.T) {
+	testCases := []struct {
+		seed     string
+		typ      KeyType
+		expected string
+	}{
+		{"cf2de378fbdd7e2ee87d486dfb5a7bff", Secp256k1, "sn259rEFXrQrWyx3Q7XneWcwV6dfL"},
+		{"4c3a1d213fbdfb14c7c28d609469b341", Ed25519, "sEdTM1uX8pu2do5XvTnutH6HsouMaM2"},
+	}
+	for _, tc := range testCases {
+		seed, _ := hex.DecodeString(tc.seed)
+		if got := EncodeSeed([SeedSize]byte(seed), tc.typ); got != tc.expected {
+			t.Errorf("EncodeSeed(%s, %s) = %s, want %s", tc.seed, tc.typ, got, tc.expected)
+		}
+		got, typ, err := DecodeSeed(tc.expected)
+		if err != nil {
+			t.Fatalf("Error occurred while decoding %s (%s).", tc.expected, err)
+		}
+		if hex.EncodeToString(got[:]) != tc.seed || typ != tc.typ {
+			t.Errorf("DecodeSeed(%s) = %x, %s, want %s, %s", tc.expected, got, typ, tc.seed, tc.typ)
+		}
+	}
+}
+
+func TestXAddress(t *testing.T) {
+	id, _ := DecodeAccountID("rGWrZyQqhTp9Xu7G5Pkayo7bXjH4k4QYpf")
+	testCases := []struct {
+		addr     XAddress
+		expected string
+	}{
+		{XAddress{AccountID: id}, "XVLhHMPHU98es4dbozjVtdWzVrDjtV5fdx1mHp98tDMoQXb"},
+		{XAddress{AccountID: id, HasTag: true}, "XVLhHMPHU98es4dbozjVtdWzVrDjtV8AqEL4xcZj5whKbmc"},
+		{XAddress{AccountID: id, HasTag: true, Tag: 1}, "XVLhHMPHU98es4dbozjVtdWzVrDjtV8xvjGQTYPiAx6gwDC"},
+		{XAddress{AccountID: id, HasTag: true, Tag: 2}, "XVLhHMPHU98es4dbozjVtdWzVrDjtV8zpDURx7DzBCkrQE7"},
+		{XAddress{AccountID: id, HasTag: true, Tag: 32}, "XVLhHMPHU98es4dbozjVtdWzVrDjtVoYiC9UvKfjKar4LJe"},
+		{XAddress{AccountID: id, HasTag: true, Tag: 276}, "XVLhHMPHU98es4dbozjVtdWzVrDjtVoKj3MnFGMXEFMnvJV"},
+		{XAddress{AccountID: id, HasTag: true, Tag: 65591}, "XVLhHMPHU98es4dbozjVtdWzVrDjtVozpjdhPQVdt3ghaWw"},
+		{XAddress{AccountID: id, HasTag: true, Tag: 16781933}, "XVLhHMPHU98es4dbozjVtdWzVrDjtVqrDUk2vDpkTjPsY73"},
+		{XAddress{AccountID: id, HasTag: true, Tag: 4294967294}, "XVLhHMPHU98es4dbozjVtdWzVrDjtV1kAsixQTdMjbWi39u"},
+		{XAddress{AccountID: id, HasTag: true, Tag: 4294967295}, "XVLhHMPHU98es4dbozjVtdWzVrDjtV18pX8yuPT7y4xaEHi"},
+		{XAddress{AccountID: id, Test: true}, "TVE26TYGhfLC7tQDno7G8dGtxSkYQn49b3qD26PK7FcGSKE"},
+		{XAddress{AccountID: id, HasTag: true, Test: true}, "TVE26TYGhfLC7tQDno7G8dGtxSkYQnSy8RHqGHoGJ59spi2"},
+		{XAddress{AccountID: id, HasTag: true, Tag: 1, Test: true}, "TVE26TYGhfLC7tQDno7G8dGtxSkYQnSz1uDimDdPYXzSpyw"},
+		{XAddress{AccountID: id, HasTag: true, Tag: 4294967295, Test: true}, "TVE26TYGhfLC7tQDno7G8dGtxSkYQnXoy6kSDh6rZzApc69"},
+	}
+	for _, tc := range testCases {
+		if got := tc.addr.String(); got != tc.expected {
+			t.Errorf("%+v = %s, want %s", tc.addr, got, tc.expected)
+		}
+		addr, err := DecodeXAddress(tc.expected)
+		if err != nil {
+			t.Fatalf("Error occurred while decoding %s (%s).", tc.expected, err)
+		}
+		if *addr != tc.addr {
+			t.Errorf("DecodeXAddress(%s) = %+v, want %+v", tc.expected, *addr, tc.addr)
+		}
+		if got, expected := addr.ClassicAddress(), "rGWrZyQqhTp9Xu7G5Pkayo7bXjH4k4QYpf"; got != expected {
+			t.Errorf("ClassicAddress() = %s, want %s", got, expected)
+		}
+	}
+}
+
+func TestDecode_Error(t *testing.T) {
+	testCases := []struct {
+		f   func() error
+		err string
+	}{
+		{
+			func() error {
+				_, err := DecodeAccountID("n9MXXueo837zYH36DvMc13BwHcqtfAWNJY5czWVbp7uYTj7x17TH")
+				return err
+			},
+			"unexpected version 1c in decoding a base58check string (expected 00)",
+		},
+		{
+			func() error { _, err := DecodeAccountID("rrrrrrrrrrrrrrrrrrrrfKh8zc"); return err },
+			"invalid length 19 in decoding a ripple address (expected 20)",
+		},
+		{
+			func() error { _, err := DecodeAccountID("rJrRMgiRgrU6hDF4pgu5DXQdWyPbY35Er1"); return err },
+			base58.ErrChecksum.Error(),
+		},
+		{
+			func() error { _, err := DecodeNodePublicKey("rJrRMgiRgrU6hDF4pgu5DXQdWyPbY35ErN"); return err },
+			"unexpected version 00 in decoding a base58check string (expected 1c)",
+		},
+		{
+			func() error { _, _, err := DecodeSeed("TC2i5A2wLNdCQysJcpTADzJusFd"); return err },
+			"unknown version or invalid length in decoding a ripple seed",
+		},
+		{
+			func() error { _, _, err := DecodeSeed("rJrRMgiRgrU6hDF4pgu5DXQdWyPbY35ErN"); return err },
+			"unknown version or invalid length in decoding a ripple seed",
+		},
+		{
+			func() error { _, _, err := DecodeSeed("sn259rEFXrQrWyx3Q7XneWcwV6d0L"); return err },
+			"invalid character at offset 27 in decoding a base58 string",
+		},
+		{
+			func() error { _, err := DecodeXAddress("XVLhHMPHU98es4dbozjVtdWzVrDjtV18pX8zeUygYrCgrPh"); return err },
+			"invalid destination tag in decoding a ripple X-address",
+		},
+		{
+			func() error { _, err := DecodeXAddress("XVLhHMPHU98es4dbozjVtdWzVrDjtV53jSo8mAyvfybtDtz"); return err },
+			"invalid destination tag in decoding a ripple X-address",
+		},
+		{
+			func() error { _, err := DecodeXAddress("XVLhHMPHU98es4dbozjVtdWzVrDjtV8AqEL4x8GQjraTqWQ"); return err },
+			"invalid destination tag in decoding a ripple X-address",
+		},
+		{
+			func() error { _, err := DecodeXAddress("XWefhSGM3bMt39McvTCeFnQWzLj5KNSFvsn2KDQ36ZgDutv"); return err },
+			"unknown version 0545 in decoding a ripple X-address",
+		},
+		{
+			func() error { _, err := DecodeXAddress("fudACbXRW5QAw9XVj4N6zr2nRrxTp6VMnk7MuZtPHPYmWn"); return err },
+			"invalid length 28 in decoding a ripple X-address (expected 29)",
+		},
+	}
+	for _, tc := range testCases {
+		err := tc.f()
+		if err == nil || err.Error() != tc.err {
+			t.Errorf("expected: %v\ngot: %v", tc.err, err)
+		}
+	}
+	_, err := DecodeAccountID("n9MXXueo837zYH36DvMc13BwHcqtfAWNJY5czWVbp7uYTj7x17TH")
+	if verr := (*base58.VersionError)(nil); !errors.As(err, &verr) {
+		t.Errorf("expected VersionError but got: %v", err)
+	}
+}
@@ -0,0 +1,149 @@
+package base58
+
+import (
+	"bytes"
+	"math"
+	"math/big"
+)
+
+// A SignMode specifies how to represent the sign of integers.
+type SignMode int
+
+const (
+	// SignPrefix encodes negative numbers with a leading '-', which is not
+	// included in the built-in alphabets. Do not use this mode with alphabets
+	// containing '-', where the representation is ambiguous.
+	SignPrefix SignMode = iota
+	// SignZigZag maps signed integers to unsigned integers so that numbers of
+	// small absolute values have short encodings; 0, -1, 1, -2, 2, ... are
+	// mapped to 0, 1, 2, 3, 4, ... respectively.
+	SignZigZag
+)
+
+// EncodeInt64 encodes the signed integer with the sign mode.
+func (enc *Encoding) EncodeInt64(n int64, mode SignMode) []byte {
+	if mode == SignZigZag {
+		return enc.EncodeUint64(uint64(n<<1) ^ uint64(n>>63))
+	}
+	if n < 0 {
+		return enc.AppendEncodeUint64([]byte{'-'}, uint64(^n)+1)
+	}
+	return enc.EncodeUint64(uint64(n))
+}
+
+// DecodeInt64 decodes the base58 encoded bytes to a signed integer with the
+// sign mode.
+func (enc *Encoding) DecodeInt64(src []byte, mode SignMode) (int64, error) {
+	if mode == SignZigZag {
+		n, err := enc.decodeUint(src, 0, 64)
+		if err != nil {
+			return 0, err
+		}
+		return int64(n>>1) ^ -int64(n&1), nil
+	}
+	if len(src) > 1 && src[0] == '-' {
+		n, err := enc.decodeUint(src, 1, 64)
+		if err != nil {
+			return 0, err
+		}
+		if n == 0 { // negative zero is not encoded by EncodeInt64
+			return 0, newCorruptInputError("base58", src, 0)
+		}
+		if n > 1<<63 {
+			return 0, overflowError("base58", src, "int64")
+		}
+		return -int64(n-1) - 1, nil
+	}
+	n, err := enc.decodeUint(src, 0, 64)
+	if err != nil {
+		return 0, err
+	}
+	if n > math.MaxInt64 {
+		return 0, overflowError("base58", src, "int64")
+	}
+	return int64(n), nil
+}
+
+// EncodeSigned encodes the number represented in the byte slice base 10, which
+// may have a leading '-', with the sign mode. Leading zeros are encoded in the
+// same way as Encode, and negative zero is encoded as zero.
+func (enc *Encoding) EncodeSigned(src []byte, mode SignMode) ([]byte, error) {
+	var start int
+	if len(src) > 0 && src[0] == '-' {
+		if len(src) == 1 {
+			return nil, newInvalidDigitError(src, 0)
+		}
+		start = 1
+	}
+	for i := start; i < len(src); i++ {
+		if decimalDecodeMap[src[i]] < 0 {
+			return nil, newInvalidDigitError(src, i)
+		}
+	}
+	zerocnt := start
+	for zerocnt < len(src) && src[zerocnt] == '0' {
+		zerocnt++
+	}
+	neg := start == 1 && zerocnt < len(src)
+	if mode == SignZigZag {
+		buf := make([]byte, zerocnt-start, len(src))
+		for i := range buf {
+			buf[i] = enc.alphabet[0]
+		}
+		if zerocnt == len(src) {
+			return buf, nil
+		}
+		x := base10Converter.parse(src[zerocnt:], &decimalDecodeMap)
+		x.Lsh(x, 1)
+		if neg {
+			x.Sub(x, big.NewInt(1))
+		}
+		return append(buf, enc.EncodeBigInt(x)...), nil
+	}
+	if neg {
+		return enc.AppendEncode([]byte{'-'}, src[start:])
+	}
+	return enc.Encode(src[start:])
+}
+
+// DecodeSigned decodes the base58 encoded bytes to the number represented in
+// base 10, which may have a leading '-', with the sign mode.
+func (enc *Encoding) DecodeSigned(src []byte, mode SignMode) ([]byte, error) {
+	var start int
+	if mode == SignPrefix && len(src) > 1 && src[0] == '-' {
+		start = 1
+	}
+	for i := start; i < len(src); i++ {
+		if enc.decodeMap[src[i]] < 0 {
+			return nil, newCorruptInputError("base58", src, i)
+		}
+	}
+	if mode == SignPrefix {
+		buf := make([]byte, start, start+DecodedLen(len(src)))
+		if start == 1 {
+			if bytes.Count(src, enc.alphabet[:1]) == len(src)-1 {
+				// negative zero is not encoded by EncodeSigned
+				return nil, newCorruptInputError("base58", src, 0)
+			}
+			buf[0] = '-'
+		}
+		return enc.AppendDecode(buf, src[start:])
+	}
+	if len(src) == 0 {
+		return []byte{}, nil
+	}
+	var zerocnt int
+	for zerocnt < len(src)-1 && src[zerocnt] == enc.alphabet[0] {
+		zerocnt++
+	}
+	x := base58Converter.parse(src[zerocnt:], &enc.decodeMap)
+	buf := make([]byte, 0, 1+DecodedLen(len(src)))
+	if x.Bit(0) == 1 {
+		buf = append(buf, '-')
+		x.Add(x, big.NewInt(1))
+	}
+	for range zerocnt {
+		buf = append(buf, '0')
+	}
+	return x.Rsh(x, 1).Append(buf, 10), nil
+}
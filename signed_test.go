@@ -0,0 +1,155 @@
+package base58
+
+import (
+	"errors"
+	"math"
+	"math/rand"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+func TestEncodeInt64(t *testing.T) {
+	testCases := []struct {
+		n      int64
+		prefix string
+		zigzag string
+	}{
+		{0, "1", "1"},
+		{-1, "-2", "2"},
+		{1, "2", "3"},
+		{-2, "-3", "4"},
+		{28, "u", "Y"},
+		{-29, "-v", "Z"},
+		{29, "v", "21"},
+		{math.MaxInt64, "npL6MjP8Qfc", "JPwcyDCgEuo"},
+		{math.MinInt64, "-npL6MjP8Qfd", "JPwcyDCgEup"},
+	}
+	for _, tc := range testCases {
+		if got := FlickrEncoding.EncodeInt64(tc.n, SignPrefix); string(got) != tc.prefix {
+			t.Errorf("EncodeInt64(%d, SignPrefix) = %s, want %s", tc.n, got, tc.prefix)
+		}
+		if got := FlickrEncoding.EncodeInt64(tc.n, SignZigZag); string(got) != tc.zigzag {
+			t.Errorf("EncodeInt64(%d, SignZigZag) = %s, want %s", tc.n, got, tc.zigzag)
+		}
+		if got, err := FlickrEncoding.DecodeInt64([]byte(tc.prefix), SignPrefix); err != nil || got != tc.n {
+			t.Errorf("DecodeInt64(%s, SignPrefix) = %d, %v, want %d", tc.prefix, got, err, tc.n)
+		}
+		if got, err := FlickrEncoding.DecodeInt64([]byte(tc.zigzag), SignZigZag); err != nil || got != tc.n {
+			t.Errorf("DecodeInt64(%s, SignZigZag) = %d, %v, want %d", tc.zigzag, got, err, tc.n)
+		}
+	}
+}
+
+func TestDecodeInt64_Error(t *testing.T) {
+	for _, src := range []string{"npL6MjP8Qfd", "-npL6MjP8Qfe", "-", "--2", "-1", "-111"} {
+		if got, err := FlickrEncoding.DecodeInt64([]byte(src), SignPrefix); err == nil {
+			t.Errorf("Error should occur while decoding %s but got %d.", src, got)
+		}
+	}
+	_, err := FlickrEncoding.DecodeInt64([]byte("npL6MjP8Qfd"), SignPrefix)
+	if expected := `overflow of int64 in decoding a base58 string "npL6MjP8Qfd"`; !errors.Is(err, ErrOverflow) || err.Error() != expected {
+		t.Errorf("expected: %v\ngot: %v", expected, err)
+	}
+	if got, err := FlickrEncoding.DecodeInt64([]byte("-2"), SignZigZag); err == nil {
+		t.Errorf("Error should occur while decoding %s but got %d.", "-2", got)
+	}
+}
+
+func TestEncodeSigned(t *testing.T) {
+	testCases := []struct {
+		decoded string
+		prefix  string
+		zigzag  string
+	}{
+		{"", "", ""},
+		{"0", "1", "1"},
+		{"000", "111", "111"},
+		{"-0", "1", "1"},
+		{"-000", "111", "111"},
+		{"1", "2", "3"},
+		{"-1", "-2", "2"},
+		{"-007", "-118", "11e"},
+		{"-18446744073709551616", "-JPwcyDCgEuq", "2tD3p8ifxjYP"},
+		{"18446744073709551616", "JPwcyDCgEuq", "2tD3p8ifxjYQ"},
+	}
+	for _, tc := range testCases {
+		got, err := FlickrEncoding.EncodeSigned([]byte(tc.decoded), SignPrefix)
+		if err != nil || string(got) != tc.prefix {
+			t.Errorf("EncodeSigned(%s, SignPrefix) = %s, %v, want %s", tc.decoded, got, err, tc.prefix)
+		}
+		got, err = FlickrEncoding.EncodeSigned([]byte(tc.decoded), SignZigZag)
+		if err != nil || string(got) != tc.zigzag {
+			t.Errorf("EncodeSigned(%s, SignZigZag) = %s, %v, want %s", tc.decoded, got, err, tc.zigzag)
+		}
+		expected := tc.decoded
+		if strings.Trim(expected, "-0") == "" { // negative zero
+			expected = strings.TrimPrefix(expected, "-")
+		}
+		got, err = FlickrEncoding.DecodeSigned([]byte(tc.prefix), SignPrefix)
+		if err != nil || string(got) != expected {
+			t.Errorf("DecodeSigned(%s, SignPrefix) = %s, %v, want %s", tc.prefix, got, err, expected)
+		}
+		got, err = FlickrEncoding.DecodeSigned([]byte(tc.zigzag), SignZigZag)
+		if err != nil || string(got) != expected {
+			t.Errorf("DecodeSigned(%s, SignZigZag) = %s, %v, want %s", tc.zigzag, got, err, expected)
+		}
+	}
+}
+
+func TestEncodeSigned_Random(t *testing.T) {
+	for _, testcase := range testcases {
+		for _, mode := range []SignMode{SignPrefix, SignZigZag} {
+			for range 100 {
+				n := int64(rand.Uint64()) >> rand.Intn(64)
+				got, err := testcase.encoding.EncodeSigned([]byte(strconv.FormatInt(n, 10)), mode)
+				if err != nil {
+					t.Fatalf("Error occurred while encoding %d (%s).", n, err)
+				}
+				if expected := testcase.encoding.EncodeInt64(n, mode); string(got) != string(expected) {
+					t.Errorf("EncodeSigned(%d) = %s, want %s", n, got, expected)
+				}
+				decoded, err := testcase.encoding.DecodeSigned(got, mode)
+				if err != nil {
+					t.Fatalf("Error occurred while decoding %s (%s).", got, err)
+				}
+				if expected := strconv.FormatInt(n, 10); string(decoded) != expected {
+					t.Errorf("DecodeSigned(%s) = %s, want %s", got, decoded, expected)
+				}
+			}
+		}
+	}
+}
+
+func TestEncodeSigned_Error(t *testing.T) {
+	testCases := []struct {
+		src string
+		err string
+	}{
+		{"-", `invalid character '-' at offset 0 in encoding a base10 number "-"`},
+		{"--1", `invalid character '-' at offset 1 in encoding a base10 number "--1"`},
+		{"-12a", `invalid character 'a' at offset 3 in encoding a base10 number "-12a"`},
+	}
+	for _, tc := range testCases {
+		for _, mode := range []SignMode{SignPrefix, SignZigZag} {
+			_, err := FlickrEncoding.EncodeSigned([]byte(tc.src), mode)
+			if err == nil || err.Error() != tc.err {
+				t.Errorf("expected: %v\ngot: %v", tc.err, err)
+			}
+		}
+	}
+	_, err := FlickrEncoding.DecodeSigned([]byte("-12O"), SignPrefix)
+	if expected := `invalid character 'O' at offset 3 in decoding a base58 string "-12O"`; err == nil || err.Error() != expected {
+		t.Errorf("expected: %v\ngot: %v", expected, err)
+	}
+	_, err = FlickrEncoding.DecodeSigned([]byte("-12"), SignZigZag)
+	if expected := `invalid character '-' at offset 0 in decoding a base58 string "-12"`; err == nil || err.Error() != expected {
+		t.Errorf("expected: %v\ngot: %v", expected, err)
+	}
+	for _, src := range []string{"-1", "-111"} {
+		_, err = FlickrEncoding.DecodeSigned([]byte(src), SignPrefix)
+		if expected := `invalid character '-' at offset 0 in decoding a base58 string "` + src + `"`; err == nil || err.Error() != expected {
+			t.Errorf("expected: %v\ngot: %v", expected, err)
+		}
+	}
+}
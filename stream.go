@@ -0,0 +1,253 @@
+package base58
+
+import "io"
+
+// A converter converts a stream of digits to the number represented in the
+// destination radix. The number is kept in little-endian limbs, each of which
+// holds limbSize digits of the destination radix, so that it can be written
+// out without building the output buffer.
+type converter struct {
+	decodeMap *[256]int64 // source character to digit, -1 if invalid
+	srcRadix  uint64
+	alphabet  []byte // destination digits
+	limbSize  int
+	limbBase  uint64 // len(alphabet)^limbSize
+	limbs     []uint64
+	zerocnt   int  // number of leading zero digits
+	started   bool // whether the most significant limb is already written
+}
+
+var (
+	decimalAlphabet  = []byte("0123456789")
+	decimalDecodeMap [256]int64
+	binaryAlphabet   = make([]byte, 256)
+	binaryDecodeMap  [256]int64
+)
+
+func init() {
+	for i := range decimalDecodeMap {
+		decimalDecodeMap[i] = -1
+	}
+	for i, c := range decimalAlphabet {
+		decimalDecodeMap[c] = int64(i)
+	}
+	for i := range binaryDecodeMap {
+		binaryAlphabet[i] = byte(i)
+		binaryDecodeMap[i] = int64(i)
+	}
+}
+
+func (enc *Encoding) encodeConverter(decodeMap *[256]int64, srcRadix uint64) *converter {
+	return &converter{
+		decodeMap: decodeMap,
+		srcRadix:  srcRadix,
+		alphabet:  enc.alphabet[:],
+		limbSize:  9,
+		limbBase:  7427658739644928, // 58^9 < math.MaxUint64/256
+	}
+}
+
+func (enc *Encoding) decodeConverter(alphabet []byte, limbSize int, limbBase uint64) *converter {
+	return &converter{
+		decodeMap: &enc.decodeMap,
+		srcRadix:  radix,
+		alphabet:  alphabet,
+		limbSize:  limbSize,
+		limbBase:  limbBase,
+	}
+}
+
+// push consumes a source character, and reports whether it is valid.
+func (c *converter) push(b byte) bool {
+	d := c.decodeMap[b]
+	if d < 0 {
+		return false
+	}
+	if len(c.limbs) == 0 && d == 0 {
+		c.zerocnt++
+		return true
+	}
+	carry := uint64(d)
+	for i, x := range c.limbs {
+		x = x*c.srcRadix + carry
+		c.limbs[i], carry = x%c.limbBase, x/c.limbBase
+	}
+	for carry > 0 {
+		c.limbs = append(c.limbs, carry%c.limbBase)
+		carry /= c.limbBase
+	}
+	return true
+}
+
+// appendZeros appends at most n leading zero digits.
+func (c *converter) appendZeros(buf []byte, n int) []byte {
+	n = min(n, c.zerocnt)
+	for range n {
+		buf = append(buf, c.alphabet[0])
+	}
+	c.zerocnt -= n
+	return buf
+}
+
+// appendLimb appends the digits of the most significant limb and drops it.
+func (c *converter) appendLimb(buf []byte) []byte {
+	i := len(c.limbs) - 1
+	x, base := c.limbs[i], uint64(len(c.alphabet))
+	c.limbs = c.limbs[:i]
+	pad, l := c.started, len(buf)
+	c.started = true
+	for j := 0; j < c.limbSize && (x > 0 || pad); j++ {
+		buf = append(buf, c.alphabet[x%base])
+		x /= base
+	}
+	for j, k := l, len(buf)-1; j < k; j, k = j+1, k-1 {
+		buf[j], buf[k] = buf[k], buf[j]
+	}
+	return buf
+}
+
+// NewEncoder returns a new base58 stream encoder. Data written to the returned
+// writer is the number represented in base 10, which is encoded and written to
+// w. The leading zeros are written while writing, and the rest is written on
+// Close. Newline characters in the input are ignored. The encoder keeps only
+// the number itself, not the input nor the output.
+func NewEncoder(enc *Encoding, w io.Writer) io.WriteCloser {
+	return &encoder{conv: enc.encodeConverter(&decimalDecodeMap, 10), w: w}
+}
+
+// NewBytesEncoder returns a new base58 stream encoder, which encodes the
+// written bytes in the same way as EncodeBytes.
+func NewBytesEncoder(enc *Encoding, w io.Writer) io.WriteCloser {
+	return &encoder{conv: enc.encodeConverter(&binaryDecodeMap, 256), w: w, binary: true}
+}
+
+type encoder struct {
+	conv   *converter
+	w      io.Writer
+	binary bool
+	offset int
+	buf    []byte
+	err    error
+}
+
+func (e *encoder) Write(p []byte) (int, error) {
+	if e.err != nil {
+		return 0, e.err
+	}
+	for i, c := range p {
+		if !e.binary && (c == '\n' || c == '\r') {
+			continue
+		}
+		if !e.conv.push(c) {
+			err := newInvalidDigitError(p, i)
+			err.Offset += e.offset
+			e.err = err
+			return i, e.err
+		}
+	}
+	e.offset += len(p)
+	if len(e.conv.limbs) == 0 {
+		if e.err = e.flushZeros(); e.err != nil {
+			return len(p), e.err // all of p is consumed
+		}
+	}
+	return len(p), nil
+}
+
+func (e *encoder) flushZeros() error {
+	for e.conv.zerocnt > 0 {
+		e.buf = e.conv.appendZeros(e.buf[:0], 1024)
+		if _, err := e.w.Write(e.buf); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Close flushes the encoded number to the underlying writer.
+func (e *encoder) Close() error {
+	if e.err != nil {
+		return e.err
+	}
+	if e.err = e.flushZeros(); e.err != nil {
+		return e.err
+	}
+	for len(e.conv.limbs) > 0 {
+		e.buf = e.buf[:0]
+		for len(e.conv.limbs) > 0 && len(e.buf) < 1024 {
+			e.buf = e.conv.appendLimb(e.buf)
+		}
+		if _, e.err = e.w.Write(e.buf); e.err != nil {
+			return e.err
+		}
+	}
+	return nil
+}
+
+// NewDecoder returns a new base58 stream decoder. It reads the base58 encoded
+// number from r, and the returned reader yields the number represented in
+// base 10. Newline characters in the input are ignored. The decoder keeps only
+// the number itself, not the input nor the output.
+func NewDecoder(enc *Encoding, r io.Reader) io.Reader {
+	return &decoder{conv: enc.decodeConverter(decimalAlphabet, 17, overflow), r: r}
+}
+
+// NewBytesDecoder returns a new base58 stream decoder, which decodes in the
+// same way as DecodeBytes.
+func NewBytesDecoder(enc *Encoding, r io.Reader) io.Reader {
+	return &decoder{conv: enc.decodeConverter(binaryAlphabet, 4, 1<<32), r: r}
+}
+
+type decoder struct {
+	conv *converter
+	r    io.Reader
+	read bool
+	buf  []byte
+	out  []byte
+	err  error
+}
+
+func (d *decoder) Read(p []byte) (int, error) {
+	if !d.read {
+		d.read, d.err = true, d.consume()
+	}
+	if d.err != nil {
+		return 0, d.err
+	}
+	if len(d.out) == 0 {
+		d.out = d.conv.appendZeros(d.buf[:0], 1024)
+		for len(d.conv.limbs) > 0 && len(d.out) < 1024 {
+			d.out = d.conv.appendLimb(d.out)
+		}
+		if len(d.out) == 0 {
+			return 0, io.EOF
+		}
+	}
+	n := copy(p, d.out)
+	d.out = d.out[n:]
+	return n, nil
+}
+
+func (d *decoder) consume() error {
+	d.buf = make([]byte, 1024+17)
+	var offset int
+	for {
+		n, err := d.r.Read(d.buf[:1024])
+		for i, c := range d.buf[:n] {
+			if c == '\n' || c == '\r' {
+				continue
+			}
+			if !d.conv.push(c) {
+				err := newCorruptInputError("base58", d.buf[:n], i)
+				err.Offset += offset
+				return err
+			}
+		}
+		offset += n
+		if err == io.EOF {
+			return nil
+		} else if err != nil {
+			return err
+		}
+	}
+}
@@ -0,0 +1,145 @@
+package base58
+
+import (
+	"bytes"
+	"encoding/hex"
+	"io"
+	"math/rand"
+	"strings"
+	"testing"
+	"testing/iotest"
+)
+
+func TestEncoder(t *testing.T) {
+	for _, testcase := range testcases {
+		for _, pair := range testcase.testpairs {
+			var sb strings.Builder
+			w := NewEncoder(testcase.encoding, &sb)
+			for i := range len(pair.decoded) {
+				if _, err := w.Write([]byte(pair.decoded[i : i+1])); err != nil {
+					t.Fatalf("Error occurred while encoding %s (%s).", pair.decoded, err)
+				}
+			}
+			if err := w.Close(); err != nil {
+				t.Fatalf("Error occurred while encoding %s (%s).", pair.decoded, err)
+			}
+			if got := sb.String(); got != pair.encoded {
+				t.Errorf("NewEncoder(%s) = %s, want %s", pair.decoded, got, pair.encoded)
+			}
+		}
+	}
+}
+
+func TestEncoder_Error(t *testing.T) {
+	w := NewEncoder(FlickrEncoding, io.Discard)
+	if _, err := w.Write([]byte("12a")); err == nil {
+		t.Errorf("Error should occur while encoding %s.", "12a")
+	}
+	if err := w.Close(); err == nil {
+		t.Errorf("Error should occur on closing the encoder after an error.")
+	}
+}
+
+func TestEncoder_WriteError(t *testing.T) {
+	r, pw := io.Pipe()
+	r.Close()
+	w := NewBytesEncoder(BitcoinEncoding, pw)
+	n, err := w.Write([]byte{0, 0})
+	if err != io.ErrClosedPipe {
+		t.Errorf("expected: %v\ngot: %v", io.ErrClosedPipe, err)
+	}
+	if expected := 2; n != expected {
+		t.Errorf("expected: %v\ngot: %v", expected, n)
+	}
+	if err := w.Close(); err != io.ErrClosedPipe {
+		t.Errorf("expected: %v\ngot: %v", io.ErrClosedPipe, err)
+	}
+}
+
+func TestDecoder(t *testing.T) {
+	for _, testcase := range testcases {
+		for _, pair := range testcase.testpairs {
+			r := NewDecoder(testcase.encoding, iotest.OneByteReader(strings.NewReader(pair.encoded)))
+			got, err := io.ReadAll(iotest.OneByteReader(r))
+			if err != nil {
+				t.Fatalf("Error occurred while decoding %s (%s).", pair.encoded, err)
+			}
+			if string(got) != pair.decoded {
+				t.Errorf("NewDecoder(%s) = %s, want %s", pair.encoded, got, pair.decoded)
+			}
+		}
+	}
+}
+
+func TestDecoder_Error(t *testing.T) {
+	r := NewDecoder(FlickrEncoding, strings.NewReader("12O"))
+	if got, err := io.ReadAll(r); err == nil {
+		t.Errorf("Error should occur while decoding %s but got %s.", "12O", got)
+	}
+}
+
+func TestBytesEncoder(t *testing.T) {
+	for _, testcase := range bytesTestcases {
+		for _, pair := range testcase.testpairs {
+			src, _ := hex.DecodeString(pair.decoded)
+			var sb strings.Builder
+			w := NewBytesEncoder(testcase.encoding, &sb)
+			if _, err := w.Write(src); err != nil {
+				t.Fatalf("Error occurred while encoding %s (%s).", pair.decoded, err)
+			}
+			if err := w.Close(); err != nil {
+				t.Fatalf("Error occurred while encoding %s (%s).", pair.decoded, err)
+			}
+			if got := sb.String(); got != pair.encoded {
+				t.Errorf("NewBytesEncoder(%s) = %s, want %s", pair.decoded, got, pair.encoded)
+			}
+		}
+	}
+}
+
+func TestBytesDecoder(t *testing.T) {
+	for _, testcase := range bytesTestcases {
+		for _, pair := range testcase.testpairs {
+			r := NewBytesDecoder(testcase.encoding, strings.NewReader(pair.encoded))
+			got, err := io.ReadAll(r)
+			if err != nil {
+				t.Fatalf("Error occurred while decoding %s (%s).", pair.encoded, err)
+			}
+			if hex.EncodeToString(got) != pair.decoded {
+				t.Errorf("NewBytesDecoder(%s) = %x, want %s", pair.encoded, got, pair.decoded)
+			}
+		}
+	}
+}
+
+func TestStream_Large(t *testing.T) {
+	src := make([]byte, 10000)
+	for i := range src {
+		src[i] = byte('0' + rand.Intn(10))
+	}
+	copy(src, "000")
+	for _, testcase := range testcases {
+		expected, err := testcase.encoding.Encode(src)
+		if err != nil {
+			t.Fatalf("Error occurred while encoding (%s).", err)
+		}
+		var buf bytes.Buffer
+		w := NewEncoder(testcase.encoding, &buf)
+		if _, err = w.Write(src); err != nil {
+			t.Fatalf("Error occurred while encoding (%s).", err)
+		}
+		if err = w.Close(); err != nil {
+			t.Fatalf("Error occurred while encoding (%s).", err)
+		}
+		if got := buf.Bytes(); !bytes.Equal(got, expected) {
+			t.Errorf("NewEncoder(%s) = %s, want %s", src, got, expected)
+		}
+		got, err := io.ReadAll(NewDecoder(testcase.encoding, &buf))
+		if err != nil {
+			t.Fatalf("Error occurred while decoding (%s).", err)
+		}
+		if !bytes.Equal(got, src) {
+			t.Errorf("NewDecoder(%s) = %s, want %s", expected, got, src)
+		}
+	}
+}
@@ -0,0 +1,186 @@
+// Package tezos implements the base58check identifiers of Tezos, which have
+// the version prefixes so that the encoded strings start with readable tags.
+package tezos
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+
+	"github.com/itchyny/base58-go"
+)
+
+// A Kind is the kind of an identifier.
+type Kind int
+
+// The kinds of identifiers. The comments are the tags of the encoded strings.
+const (
+	BlockHash                   Kind = iota // B
+	OperationHash                           // o
+	OperationListHash                       // Lo
+	OperationListListHash                   // LLo
+	ProtocolHash                            // P
+	ContextHash                             // Co
+	BlockPayloadHash                        // vh
+	NonceHash                               // nce
+	ScriptExprHash                          // expr
+	ChainID                                 // Net
+	Ed25519PublicKeyHash                    // tz1
+	Secp256k1PublicKeyHash                  // tz2
+	P256PublicKeyHash                       // tz3
+	BLS12381PublicKeyHash                   // tz4
+	ContractHash                            // KT1
+	TxRollupHash                            // txr1
+	SmartRollupHash                         // sr1
+	SmartRollupCommitmentHash               // src1
+	SmartRollupStateHash                    // srs1
+	CryptoboxPublicKeyHash                  // id
+	Ed25519PublicKey                        // edpk
+	Secp256k1PublicKey                      // sppk
+	P256PublicKey                           // p2pk
+	BLS12381PublicKey                       // BLpk
+	Ed25519Seed                             // edsk
+	Ed25519SecretKey                        // edsk
+	Secp256k1SecretKey                      // spsk
+	P256SecretKey                           // p2sk
+	BLS12381SecretKey                       // BLsk
+	Ed25519EncryptedSeed                    // edesk
+	Secp256k1EncryptedSecretKey             // spesk
+	P256EncryptedSecretKey                  // p2esk
+	Ed25519Signature                        // edsig
+	Secp256k1Signature                      // spsig1
+	P256Signature                           // p2sig
+	GenericSignature                        // sig
+	BLS12381Signature                       // BLsig
+)
+
+var kinds = [...]struct {
+	name    string
+	tag     string
+	version []byte
+	size    int
+}{
+	BlockHash:                   {"block hash", "B", []byte{1, 52}, 32},
+	OperationHash:               {"operation hash", "o", []byte{5, 116}, 32},
+	OperationListHash:           {"operation list hash", "Lo", []byte{133, 233}, 32},
+	OperationListListHash:       {"operation list list hash", "LLo", []byte{29, 159, 109}, 32},
+	ProtocolHash:                {"protocol hash", "P", []byte{2, 170}, 32},
+	ContextHash:                 {"context hash", "Co", []byte{79, 199}, 32},
+	BlockPayloadHash:            {"block payload hash", "vh", []byte{1, 106, 242}, 32},
+	NonceHash:                   {"nonce hash", "nce", []byte{69, 220, 169}, 32},
+	ScriptExprHash:              {"script expression hash", "expr", []byte{13, 44, 64, 27}, 32},
+	ChainID:                     {"chain id", "Net", []byte{87, 82, 0}, 4},
+	Ed25519PublicKeyHash:        {"ed25519 public key hash", "tz1", []byte{6, 161, 159}, 20},
+	Secp256k1PublicKeyHash:      {"secp256k1 public key hash", "tz2", []byte{6, 161, 161}, 20},
+	P256PublicKeyHash:           {"p256 public key hash", "tz3", []byte{6, 161, 164}, 20},
+	BLS12381PublicKeyHash:       {"bls12-381 public key hash", "tz4", []byte{6, 161, 166}, 20},
+	ContractHash:                {"contract hash", "KT1", []byte{2, 90, 121}, 20},
+	TxRollupHash:                {"tx rollup hash", "txr1", []byte{1, 128, 120, 31}, 20},
+	SmartRollupHash:             {"smart rollup hash", "sr1", []byte{6, 124, 117}, 20},
+	SmartRollupCommitmentHash:   {"smart rollup commitment hash", "src1", []byte{17, 165, 134, 138}, 32},
+	SmartRollupStateHash:        {"smart rollup state hash", "srs1", []byte{17, 165, 235, 240}, 32},
+	CryptoboxPublicKeyHash:      {"cryptobox public key hash", "id", []byte{153, 103}, 16},
+	Ed25519PublicKey:            {"ed25519 public key", "edpk", []byte{13, 15, 37, 217}, 32},
+	Secp256k1PublicKey:          {"secp256k1 public key", "sppk", []byte{3, 254, 226, 86}, 33},
+	P256PublicKey:               {"p256 public key", "p2pk", []byte{3, 178, 139, 127}, 33},
+	BLS12381PublicKey:           {"bls12-381 public key", "BLpk", []byte{6, 149, 135, 204}, 48},
+	Ed25519Seed:                 {"ed25519 seed", "edsk", []byte{13, 15, 58, 7}, 32},
+	Ed25519SecretKey:            {"ed25519 secret key", "edsk", []byte{43, 246, 78, 7}, 64},
+	Secp256k1SecretKey:          {"secp256k1 secret key", "spsk", []byte{17, 162, 224, 201}, 32},
+	P256SecretKey:               {"p256 secret key", "p2sk", []byte{16, 81, 238, 189}, 32},
+	BLS12381SecretKey:           {"bls12-381 secret key", "BLsk", []byte{3, 150, 192, 40}, 32},
+	Ed25519EncryptedSeed:        {"ed25519 encrypted seed", "edesk", []byte{7, 90, 60, 179, 41}, 56},
+	Secp256k1EncryptedSecretKey: {"secp256k1 encrypted secret key", "spesk", []byte{9, 237, 241, 174, 150}, 56},
+	P256EncryptedSecretKey:      {"p256 encrypted secret key", "p2esk", []byte{9, 48, 57, 115, 171}, 56},
+	Ed25519Signature:            {"ed25519 signature", "edsig", []byte{9, 245, 205, 134, 18}, 64},
+	Secp256k1Signature:          {"secp256k1 signature", "spsig1", []byte{13, 115, 101, 19, 63}, 64},
+	P256Signature:               {"p256 signature", "p2sig", []byte{54, 240, 44, 52}, 64},
+	GenericSignature:            {"generic signature", "sig", []byte{4, 130, 43}, 64},
+	BLS12381Signature:           {"bls12-381 signature", "BLsig", []byte{40, 171, 64, 207}, 96},
+}
+
+func (kind Kind) valid() bool {
+	return 0 <= kind && int(kind) < len(kinds)
+}
+
+// String returns the name of the kind.
+func (kind Kind) String() string {
+	if !kind.valid() {
+		return fmt.Sprintf("Kind(%d)", int(kind))
+	}
+	return kinds[kind].name
+}
+
+// Tag returns the leading characters of the encoded strings of the kind, or
+// an empty string for an unknown kind.
+func (kind Kind) Tag() string {
+	if !kind.valid() {
+		return ""
+	}
+	return kinds[kind].tag
+}
+
+// PayloadSize returns the size of the payload of the kind, or -1 for an
+// unknown kind.
+func (kind Kind) PayloadSize() int {
+	if !kind.valid() {
+		return -1
+	}
+	return kinds[kind].size
+}
+
+// Encode encodes the payload of the kind in base58check with the version
+// prefix of the kind.
+func Encode(kind Kind, payload []byte) (string, error) {
+	if !kind.valid() {
+		return "", fmt.Errorf("unknown kind %d in encoding a tezos identifier", int(kind))
+	}
+	if k := kinds[kind]; len(payload) != k.size {
+		return "", fmt.Errorf("invalid length %d in encoding a tezos %s (expected %d)",
+			len(payload), k.name, k.size)
+	}
+	return string(base58.BitcoinEncoding.CheckEncode(kinds[kind].version, payload)), nil
+}
+
+// Decode decodes the base58check string, and returns the kind identified by
+// the version prefix and the payload. The error messages do not contain the
+// input nor the decoded bytes, since the input may be a secret key.
+func Decode(src string) (Kind, []byte, error) {
+	_, buf, err := base58.BitcoinEncoding.CheckDecode([]byte(src), 0)
+	if err != nil {
+		if cerr := (*base58.CorruptInputError)(nil); errors.As(err, &cerr) {
+			err = cerr.Redact()
+		}
+		return 0, nil, err
+	}
+	found := Kind(-1)
+	for kind, k := range kinds {
+		if bytes.HasPrefix(buf, k.version) {
+			if len(buf) == len(k.version)+k.size {
+				return Kind(kind), buf[len(k.version):], nil
+			}
+			found = Kind(kind)
+		}
+	}
+	clear(buf)
+	if found < 0 {
+		return 0, nil, errors.New("unknown prefix in decoding a tezos identifier")
+	}
+	k := kinds[found]
+	return 0, nil, fmt.Errorf("invalid length %d in decoding a tezos %s (expected %d)",
+		len(buf)-len(k.version), k.name, k.size)
+}
+
+// DecodeKind decodes the base58check string of the kind, and returns the
+// payload.
+func DecodeKind(src string, kind Kind) ([]byte, error) {
+	got, payload, err := Decode(src)
+	if err != nil {
+		return nil, err
+	}
+	if got != kind {
+		clear(payload)
+		return nil, fmt.Errorf("unexpected %s in decoding a tezos %s", got, kind)
+	}
+	return payload, nil
+}
@@ -0,0 +1,128 @@
+package tezos
+
+import (
+	"bytes"
+	"encoding/hex"
+	"strings"
+	"testing"
+
+	"github.com/itchyny/base58-go"
+)
+
+func TestEncodeDecode(t *testing.T) {
+	testCases := []struct {
+		kind     Kind
+		payload  string
+		expected string
+	}{
+		{Ed25519PublicKeyHash, "02298c03ed7d454a101eb7022bc95f7e5f41ac78", "tz1KqTpEZ7Yob7QbPE4Hy4Wo8fHG8LhKxZSx"},
+		{ContractHash, "1d23c1d3d2f8a4ea5e8784b8f7ecf2ad304c0fe6", "KT1BEqzn5Wx8uJrZNvuS9DVHmLvG9td3fDLi"},
+		{Ed25519PublicKey, "4798d2cc98473d7e250c898885718afd2e4efbcb1a1595ab9730761ed830de0f",
+			"edpkuBknW28nW72KG6RoHtYW7p12T6GKc7nAbwYX5m8Wd9sDVC9yav"},
+		{BlockHash, strings.Repeat("00", 32), "BKiHLREqU3JkXfzEDYAkmmfX48gBDtYhMrpA98s7Aq4SzbUAB6M"},
+		{ChainID, "00000000", "NetXH12Aer3be93"},
+		{Ed25519Seed, strings.Repeat("00", 32), "edsk2fuHAameH2ugtQy1ojXnrJMk7NyEc6tWwejFGr2SkhFf3MXE4i"},
+		{Ed25519SecretKey, strings.Repeat("00", 64),
+			"edskRc1n1hXn5XCoj1T93VUvgeTKnbNgWwaWMeiFSDgidfryadeoE598yctqThA2Kb1ePuhY99JUMTK2cPf1ngJLADLPDnpQvH"},
+	}
+	for _, tc := range testCases {
+		payload, _ := hex.DecodeString(tc.payload)
+		got, err := Encode(tc.kind, payload)
+		if err != nil {
+			t.Fatalf("Error occurred while encoding %s (%s).", tc.payload, err)
+		}
+		if got != tc.expected {
+			t.Errorf("Encode(%s, %s) = %s, want %s", tc.kind, tc.payload, got, tc.expected)
+		}
+		kind, decoded, err := Decode(tc.expected)
+		if err != nil {
+			t.Fatalf("Error occurred while decoding %s (%s).", tc.expected, err)
+		}
+		if kind != tc.kind || !bytes.Equal(decoded, payload) {
+			t.Errorf("Decode(%s) = %s, %x, want %s, %s", tc.expected, kind, decoded, tc.kind, tc.payload)
+		}
+	}
+}
+
+func TestKinds(t *testing.T) {
+	for kind := range Kind(len(kinds)) {
+		for _, b := range []byte{0x00, 0xff} {
+			got, err := Encode(kind, bytes.Repeat([]byte{b}, kind.PayloadSize()))
+			if err != nil {
+				t.Fatalf("Error occurred while encoding %s (%s).", kind, err)
+			}
+			if !strings.HasPrefix(got, kind.Tag()) {
+				t.Errorf("Encode(%s) = %s, should start with %s", kind, got, kind.Tag())
+			}
+			payload, err := DecodeKind(got, kind)
+			if err != nil {
+				t.Fatalf("Error occurred while decoding %s (%s).", got, err)
+			}
+			if len(payload) != kind.PayloadSize() {
+				t.Errorf("DecodeKind(%s, %s) has length %d, want %d", got, kind, len(payload), kind.PayloadSize())
+			}
+		}
+	}
+}
+
+func TestKinds_Unknown(t *testing.T) {
+	for _, kind := range []Kind{-1, Kind(len(kinds)), 100} {
+		if got := kind.Tag(); got != "" {
+			t.Errorf("%s.Tag() = %s, want empty", kind, got)
+		}
+		if got := kind.PayloadSize(); got != -1 {
+			t.Errorf("%s.PayloadSize() = %d, want -1", kind, got)
+		}
+	}
+}
+
+func TestDecode_Error(t *testing.T) {
+	testCases := []struct {
+		f   func() error
+		err string
+	}{
+		{
+			func() error { _, err := Encode(ContractHash, make([]byte, 21)); return err },
+			"invalid length 21 in encoding a tezos contract hash (expected 20)",
+		},
+		{
+			func() error { _, err := Encode(Kind(100), nil); return err },
+			"unknown kind 100 in encoding a tezos identifier",
+		},
+		{
+			func() error {
+				_, _, err := Decode(string(base58.BitcoinEncoding.CheckEncode([]byte{6, 161, 159}, make([]byte, 21))))
+				return err
+			},
+			"invalid length 21 in decoding a tezos ed25519 public key hash (expected 20)",
+		},
+		{
+			func() error { _, _, err := Decode("1A1zP1eP5QGefi2DMPTfTL5SLmv7DivfNa"); return err },
+			"unknown prefix in decoding a tezos identifier",
+		},
+		{
+			func() error { _, _, err := Decode("tz1KqTpEZ7Yob7QbPE4Hy4Wo8fHG8LhKxZSy"); return err },
+			base58.ErrChecksum.Error(),
+		},
+		{
+			func() error {
+				_, _, err := Decode("edsk2fuHAameH2ugtQy1ojXnrJMk7NyEc6tWwejFGr2SkhFf3MXE40")
+				return err
+			},
+			"invalid character at offset 53 in decoding a base58 string",
+		},
+		{
+			func() error {
+				_, err := DecodeKind("KT1BEqzn5Wx8uJrZNvuS9DVHmLvG9td3fDLi", Ed25519PublicKeyHash)
+				return err
+			},
+			"unexpected contract hash in decoding a tezos ed25519 public key hash",
+		},
+	}
+	for _, tc := range testCases {
+		err := tc.f()
+		if err == nil || err.Error() != tc.err {
+			t.Errorf("expected: %v\ngot: %v", tc.err, err)
+		}
+	}
+}
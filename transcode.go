@@ -0,0 +1,17 @@
+package base58
+
+// Transcode converts the encoded bytes of the src encoding to the encoding of
+// the dst encoding. Since the radix is the same, each character is mapped to
+// the character of the same digit, so this function runs in linear time, and
+// leading zeros are preserved.
+func Transcode(dst, src *Encoding, in []byte) ([]byte, error) {
+	buf := make([]byte, len(in))
+	for i, c := range in {
+		d := src.decodeMap[c]
+		if d < 0 {
+			return nil, newCorruptInputError("base58", in, i)
+		}
+		buf[i] = dst.alphabet[d]
+	}
+	return buf, nil
+}
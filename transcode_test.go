@@ -0,0 +1,51 @@
+package base58
+
+import (
+	"math/rand"
+	"strconv"
+	"testing"
+)
+
+func TestTranscode(t *testing.T) {
+	for _, src := range testcases {
+		for _, dst := range testcases {
+			for i, pair := range src.testpairs {
+				got, err := Transcode(dst.encoding, src.encoding, []byte(pair.encoded))
+				if err != nil {
+					t.Fatalf("Error occurred while transcoding %s (%s).", pair.encoded, err)
+				}
+				if expected := dst.testpairs[i].encoded; string(got) != expected {
+					t.Errorf("Transcode(%s, %s, %s) = %s, want %s",
+						dst.encoding, src.encoding, pair.encoded, got, expected)
+				}
+			}
+		}
+	}
+}
+
+func TestTranscode_Random(t *testing.T) {
+	for range 100 {
+		n := rand.Uint64() >> rand.Intn(64)
+		got, err := Transcode(BitcoinEncoding, FlickrEncoding, FlickrEncoding.EncodeUint64(n))
+		if err != nil {
+			t.Fatalf("Error occurred while transcoding %d (%s).", n, err)
+		}
+		if expected := BitcoinEncoding.EncodeUint64(n); string(got) != string(expected) {
+			t.Errorf("Transcode(%d) = %s, want %s", n, got, expected)
+		}
+		decoded, err := BitcoinEncoding.Decode(got)
+		if err != nil {
+			t.Fatalf("Error occurred while decoding %s (%s).", got, err)
+		}
+		if expected := strconv.FormatUint(n, 10); string(decoded) != expected {
+			t.Errorf("Decode(%s) = %s, want %s", got, decoded, expected)
+		}
+	}
+}
+
+func TestTranscode_Error(t *testing.T) {
+	_, err := Transcode(BitcoinEncoding, FlickrEncoding, []byte("abcO"))
+	if expected := `invalid character 'O' at offset 3 in decoding a base58 string "abcO"`; err == nil || err.Error() != expected {
+		t.Errorf("expected: %v\ngot: %v", expected, err)
+	}
+}